@@ -0,0 +1,95 @@
+package laravalidate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrUnknownRule is used for rules that are not registered
+	ErrUnknownRule = errors.New("unknown validation rule")
+	// ErrArgCount is used for rules with too few or too many args, see RegisterArgCount
+	ErrArgCount = errors.New("wrong number of arguments")
+	// ErrInvalidArgs is used for rules with args that could not be prepared, see RegisterPrepare
+	ErrInvalidArgs = errors.New("invalid arguments")
+)
+
+// RuleError describes an invalid rule within the struct tag of a field
+// The Err is one of ErrUnknownRule, ErrArgCount or ErrInvalidArgs
+type RuleError struct {
+	// Type is the struct type containing the field
+	Type reflect.Type
+	// Field is the go name of the field
+	Field string
+	// Tag is the struct tag containing the rule, validate, validateInner or validateKey
+	Tag  string
+	Rule string
+	Err  error
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("%s.%s: rule %q in %s tag: %s", e.Type, e.Field, e.Rule, e.Tag, e.Err)
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// Check checks the rules of the types using the default instance, see (*Instance).Check
+func Check(types ...any) error {
+	return defaultInstance.Check(types...)
+}
+
+// Check parses the rules of the given types and returns all invalid rules, this is meant to be used within a unit test.
+// Fields, list elements and map entries are checked as well.
+// The types can be values or pointers of a type or a reflect.Type.
+//
+// The returned error joins a *RuleError per invalid rule, nil is returned if all rules are valid.
+func (i *Instance) Check(types ...any) error {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	checked := map[reflect.Type]struct{}{}
+	var errs []error
+	for _, value := range types {
+		valueType, ok := value.(reflect.Type)
+		if !ok {
+			valueType = reflect.TypeOf(value)
+		}
+		errs = i.checkType(valueType, checked, errs)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (i *Instance) checkType(valueType reflect.Type, checked map[reflect.Type]struct{}, errs []error) []error {
+	if valueType == nil {
+		return errs
+	}
+
+	switch valueType.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return i.checkType(valueType.Elem(), checked, errs)
+	case reflect.Map:
+		errs = i.checkType(valueType.Key(), checked, errs)
+		return i.checkType(valueType.Elem(), checked, errs)
+	case reflect.Struct:
+		// Continue below
+	default:
+		return errs
+	}
+
+	if _, ok := checked[valueType]; ok {
+		return errs
+	}
+	checked[valueType] = struct{}{}
+
+	plan := i.compileStructPlan(valueType)
+	errs = append(errs, plan.ruleErrors...)
+	for _, field := range plan.fields {
+		errs = i.checkType(field.fieldType, checked, errs)
+	}
+
+	return errs
+}
@@ -0,0 +1,98 @@
+package laravalidate
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type checkTestAddress struct {
+	Street string `json:"street" validate:"requierd"`
+}
+
+type checkTestBody struct {
+	Name      string                      `json:"name" validate:"required|max:abc"`
+	Age       int                         `json:"age" validate:"between:1"`
+	Tags      []string                    `json:"tags" validateInner:"regex:^[a-z]+$"`
+	Addresses map[string]checkTestAddress `json:"addresses" validateKey:"in"`
+	Parent    *checkTestBody              `json:"parent"`
+}
+
+type checkTestValid struct {
+	Name  string   `json:"name" validate:"required|max:10"`
+	Email string   `json:"email" validate:"required_without:Name|email:dns"`
+	Tags  []string `json:"tags" validateInner:"in:a,b,c"`
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check(checkTestValid{}, &checkTestValid{}, []checkTestValid{}))
+
+	err := Check(checkTestBody{})
+	assert.Error(t, err)
+
+	ruleErrors := []string{}
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var ruleErr *RuleError
+		if assert.True(t, errors.As(err, &ruleErr)) {
+			ruleErrors = append(ruleErrors, ruleErr.Field+"."+ruleErr.Tag+"."+ruleErr.Rule)
+		}
+	}
+	assert.Equal(t, []string{
+		"Name.validate.max",
+		"Age.validate.between",
+		"Tags.validateInner.regex",
+		"Addresses.validateKey.in",
+		"Street.validate.requierd",
+	}, ruleErrors)
+
+	assert.ErrorIs(t, err, ErrUnknownRule)
+	assert.ErrorIs(t, err, ErrArgCount)
+	assert.ErrorIs(t, err, ErrInvalidArgs)
+	assert.Contains(t, err.Error(), `laravalidate.checkTestAddress.Street: rule "requierd" in validate tag: unknown validation rule`)
+	assert.Contains(t, err.Error(), `laravalidate.checkTestBody.Age: rule "between" in validate tag: wrong number of arguments, expected 2 but got 1`)
+
+	// Types can also be given as reflect.Type
+	assert.Error(t, Check(reflect.TypeOf(checkTestAddress{})))
+}
+
+func TestStrict(t *testing.T) {
+	type Test struct {
+		Name string `json:"name" validate:"requierd"`
+	}
+
+	// By default unknown rules are skipped
+	assert.NoError(t, New().JsonValidate(nil, nil, Test{}))
+
+	instance := New(WithStrict())
+	err := instance.JsonValidate(nil, nil, Test{})
+	assert.ErrorIs(t, err, ErrUnknownRule)
+
+	var validationErr *ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+
+	instance.SetStrict(false)
+	assert.NoError(t, instance.JsonValidate(nil, nil, Test{}))
+}
+
+func TestInvalidArgs(t *testing.T) {
+	type Test struct {
+		After *time.Time `json:"after" validate:"after"`
+		Max   int        `json:"max" validate:"max:abc"`
+	}
+
+	// Rules with the wrong number of args or invalid args fail for every value
+	now := time.Now()
+	for _, input := range []Test{{}, {After: &now, Max: 1}} {
+		err := New().JsonValidate(nil, nil, input)
+		typedErr, ok := err.(*ValidationError)
+		if assert.True(t, ok) && assert.Len(t, typedErr.Errors, 2) {
+			assert.Equal(t, "after", typedErr.Errors[0].Errors[0].Rule)
+			assert.Equal(t, "args", typedErr.Errors[0].Errors[0].Hint)
+			assert.Equal(t, "max", typedErr.Errors[1].Errors[0].Rule)
+			assert.Equal(t, "args", typedErr.Errors[1].Errors[0].Hint)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package laravalidate
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+const (
+	ContentTypeKey = "content-type" // Stores the detected content type of the value so the content only has to be read once
+)
+
+// sniffLen is the amount of bytes read to detect the content type, this equals the amount used by http.DetectContentType
+const sniffLen = 512
+
+// ContentType contains the content type of a file
+type ContentType struct {
+	// Detected is the mimetype detected from the content
+	Detected string
+	// Declared is the mimetype the client claimed the file has, this is empty if unknown
+	// For uploaded files this is the Content-Type of the part or the mimetype of the file extension
+	Declared string
+}
+
+type contentSignaturePart struct {
+	offset int
+	bytes  string
+}
+
+type contentSignature struct {
+	mimetype string
+	parts    []contentSignaturePart
+}
+
+// contentSignatures contains magic numbers of common formats within the extensions table
+// These are checked before falling back to http.DetectContentType, more specific signatures should be placed first
+var contentSignatures = []contentSignature{
+	{"image/png", []contentSignaturePart{{0, "\x89PNG\r\n\x1a\n"}}},
+	{"image/jpeg", []contentSignaturePart{{0, "\xff\xd8\xff"}}},
+	{"image/gif", []contentSignaturePart{{0, "GIF87a"}}},
+	{"image/gif", []contentSignaturePart{{0, "GIF89a"}}},
+	{"image/webp", []contentSignaturePart{{0, "RIFF"}, {8, "WEBP"}}},
+	{"image/bmp", []contentSignaturePart{{0, "BM"}, {6, "\x00\x00\x00\x00"}}},
+	{"image/tiff", []contentSignaturePart{{0, "II*\x00"}}},
+	{"image/tiff", []contentSignaturePart{{0, "MM\x00*"}}},
+	{"image/x-icon", []contentSignaturePart{{0, "\x00\x00\x01\x00"}}},
+	{"image/avif", []contentSignaturePart{{4, "ftypavif"}}},
+	{"image/jxl", []contentSignaturePart{{0, "\xff\x0a"}}},
+	{"image/jxl", []contentSignaturePart{{0, "\x00\x00\x00\x0cJXL \x0d\x0a\x87\x0a"}}},
+	{"image/vnd.adobe.photoshop", []contentSignaturePart{{0, "8BPS"}}},
+	{"application/pdf", []contentSignaturePart{{0, "%PDF-"}}},
+	{"application/postscript", []contentSignaturePart{{0, "%!PS"}}},
+	{"application/rtf", []contentSignaturePart{{0, "{\\rtf"}}},
+	{"application/vnd.oasis.opendocument.text", []contentSignaturePart{{0, "PK\x03\x04"}, {30, "mimetypeapplication/vnd.oasis.opendocument.text"}}},
+	{"application/vnd.oasis.opendocument.spreadsheet", []contentSignaturePart{{0, "PK\x03\x04"}, {30, "mimetypeapplication/vnd.oasis.opendocument.spreadsheet"}}},
+	{"application/vnd.oasis.opendocument.presentation", []contentSignaturePart{{0, "PK\x03\x04"}, {30, "mimetypeapplication/vnd.oasis.opendocument.presentation"}}},
+	{"application/epub+zip", []contentSignaturePart{{0, "PK\x03\x04"}, {30, "mimetypeapplication/epub+zip"}}},
+	{"application/zip", []contentSignaturePart{{0, "PK\x03\x04"}}},
+	{"application/zip", []contentSignaturePart{{0, "PK\x05\x06"}}},
+	{"application/x-7z-compressed", []contentSignaturePart{{0, "7z\xbc\xaf\x27\x1c"}}},
+	{"application/x-rar-compressed", []contentSignaturePart{{0, "Rar!\x1a\x07"}}},
+	{"application/x-bzip2", []contentSignaturePart{{0, "BZh"}}},
+	{"application/x-xz", []contentSignaturePart{{0, "\xfd7zXZ\x00"}}},
+	{"application/x-tar", []contentSignaturePart{{257, "ustar"}}},
+	{"application/vnd.ms-cab-compressed", []contentSignaturePart{{0, "MSCF"}}},
+	{"application/x-ole-storage", []contentSignaturePart{{0, "\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1"}}},
+	{"application/x-msdownload", []contentSignaturePart{{0, "MZ"}}},
+	{"application/wasm", []contentSignaturePart{{0, "\x00asm"}}},
+	{"application/java-vm", []contentSignaturePart{{0, "\xca\xfe\xba\xbe"}}},
+	{"application/x-shockwave-flash", []contentSignaturePart{{0, "FWS"}}},
+	{"application/x-shockwave-flash", []contentSignaturePart{{0, "CWS"}}},
+	{"audio/mpeg", []contentSignaturePart{{0, "ID3"}}},
+	{"audio/ogg", []contentSignaturePart{{0, "OggS"}}},
+	{"audio/x-flac", []contentSignaturePart{{0, "fLaC"}}},
+	{"audio/x-wav", []contentSignaturePart{{0, "RIFF"}, {8, "WAVE"}}},
+	{"audio/midi", []contentSignaturePart{{0, "MThd"}}},
+	{"audio/mp4", []contentSignaturePart{{4, "ftypM4A"}}},
+	{"video/x-msvideo", []contentSignaturePart{{0, "RIFF"}, {8, "AVI "}}},
+	{"video/quicktime", []contentSignaturePart{{4, "ftypqt"}}},
+	{"video/3gpp", []contentSignaturePart{{4, "ftyp3gp"}}},
+	{"video/mp4", []contentSignaturePart{{4, "ftyp"}}},
+	{"video/webm", []contentSignaturePart{{0, "\x1a\x45\xdf\xa3"}, {31, "webm"}}},
+	{"video/x-matroska", []contentSignaturePart{{0, "\x1a\x45\xdf\xa3"}}},
+	{"video/x-flv", []contentSignaturePart{{0, "FLV"}}},
+	{"video/mpeg", []contentSignaturePart{{0, "\x00\x00\x01\xba"}}},
+	{"font/woff", []contentSignaturePart{{0, "wOFF"}}},
+	{"font/woff2", []contentSignaturePart{{0, "wOF2"}}},
+	{"font/ttf", []contentSignaturePart{{0, "\x00\x01\x00\x00\x00"}}},
+	{"font/otf", []contentSignaturePart{{0, "OTTO"}}},
+}
+
+// containerMimetypes contains formats that are stored within a generic container format
+// The content of these formats is detected as the container so they are allowed if the container is detected
+var containerMimetypes = map[string][]string{
+	"application/zip": {
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/java-archive",
+		"application/vnd.android.package-archive",
+	},
+	"application/x-ole-storage": {
+		"application/msword",
+		"application/vnd.ms-excel",
+		"application/vnd.ms-powerpoint",
+		"application/x-msdownload",
+	},
+}
+
+// detectContentType detects the mimetype of the start of a file
+func detectContentType(content []byte) string {
+outer:
+	for _, signature := range contentSignatures {
+		for _, part := range signature.parts {
+			end := part.offset + len(part.bytes)
+			if end > len(content) || string(content[part.offset:end]) != part.bytes {
+				continue outer
+			}
+		}
+		return signature.mimetype
+	}
+
+	detected := http.DetectContentType(content)
+	mediaType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return detected
+	}
+
+	if (mediaType == "text/xml" || mediaType == "text/plain") && strings.Contains(string(content), "<svg") {
+		return "image/svg+xml"
+	}
+
+	return mediaType
+}
+
+// contentTypeMatches returns if a detected mimetype satisfies an allowed mimetype
+// The allowed mimetype can end with a * to do a prefix match, for example image/*
+func contentTypeMatches(detected string, allowed string) bool {
+	if strings.HasSuffix(allowed, "*") {
+		return strings.HasPrefix(detected, allowed[:len(allowed)-1])
+	}
+
+	if detected == allowed {
+		return true
+	}
+
+	switch detected {
+	case "text/plain":
+		// Plain text can't be told apart from other text based formats
+		return strings.HasPrefix(allowed, "text/") || allowed == "application/json"
+	case "text/xml":
+		return allowed == "application/xml" || strings.HasSuffix(allowed, "+xml")
+	}
+
+	for _, contained := range containerMimetypes[detected] {
+		if contained == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContentType detects the content type of the field under validation by reading the start of the content
+//
+// Supported values are []byte, io.ReaderAt and multipart.FileHeader
+func (ctx *ValidatorCtx) ContentType() (ContentType, ConvertStatus) {
+	state, ok := ctx.GetState(ContentTypeKey)
+	if ok {
+		contentType, ok := state.(ContentType)
+		if ok {
+			return contentType, ConverstionOk
+		}
+	}
+
+	contentType, status := ctx.Needle.contentType()
+	if status.Oke() {
+		ctx.SetState(ContentTypeKey, contentType)
+	}
+	return contentType, status
+}
+
+func (n *Needle) contentType() (ContentType, ConvertStatus) {
+	if n.IsFile() {
+		file, status := n.File()
+		if !status.Oke() {
+			return ContentType{}, status
+		}
+
+		f, err := file.Open()
+		if err != nil {
+			return ContentType{}, Invalid
+		}
+		defer f.Close()
+
+		content, ok := readContentStart(f)
+		if !ok {
+			return ContentType{}, Invalid
+		}
+
+		declared, _, err := mime.ParseMediaType(file.Header.Get("Content-Type"))
+		if err != nil || declared == "application/octet-stream" {
+			declared = extensions[strings.ToLower(strings.TrimPrefix(filepath.Ext(file.Filename), "."))]
+		}
+
+		return ContentType{Detected: detectContentType(content), Declared: declared}, ConverstionOk
+	}
+
+	if n.Kind() == reflect.Slice && n.Type.Elem().Kind() == reflect.Uint8 {
+		if !n.HasValue() || n.Value.IsNil() {
+			return ContentType{}, ValueNil
+		}
+
+		content := n.Value.Bytes()
+		if len(content) > sniffLen {
+			content = content[:sniffLen]
+		}
+		return ContentType{Detected: detectContentType(content)}, ConverstionOk
+	}
+
+	readerAtType := reflect.TypeOf((*io.ReaderAt)(nil)).Elem()
+	if !n.Type.Implements(readerAtType) && !reflect.PointerTo(n.Type).Implements(readerAtType) {
+		return ContentType{}, InvalidType
+	}
+
+	if !n.HasValue() || isNil(n) || !n.Value.CanInterface() {
+		return ContentType{}, ValueNil
+	}
+
+	reader, ok := n.Value.Interface().(io.ReaderAt)
+	if !ok {
+		if !n.Value.CanAddr() {
+			return ContentType{}, InvalidType
+		}
+		reader = n.Value.Addr().Interface().(io.ReaderAt)
+	}
+
+	content, ok := readContentStart(reader)
+	if !ok {
+		return ContentType{}, Invalid
+	}
+	return ContentType{Detected: detectContentType(content)}, ConverstionOk
+}
+
+// readContentStart reads the bytes needed to detect the content type
+func readContentStart(reader io.ReaderAt) ([]byte, bool) {
+	content := make([]byte, sniffLen)
+	n, err := reader.ReadAt(content, 0)
+	if err != nil && err != io.EOF {
+		return nil, false
+	}
+
+	return content[:n], true
+}
@@ -0,0 +1,67 @@
+package dbrules
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Backend looks up values for the exists and unique rules
+//
+// The table and column names are validated by the rules before the backend is called, see AddBackendRules.
+type Backend interface {
+	// Exists reports for every value if a row exists within the table where the column equals the value and all conditions match
+	// The returned slice must have the same length as values.
+	// Errors are treated as infrastructure failures and abort the validation, see (*laravalidate.ValidatorCtx).Abort
+	Exists(ctx context.Context, table string, column string, values []any, conditions []Condition) ([]bool, error)
+}
+
+type Operator uint8
+
+const (
+	Equal     Operator = iota // column = value
+	NotEqual                  // column <> value
+	IsNull                    // column IS NULL
+	IsNotNull                 // column IS NOT NULL
+	Raw                       // A condition of a scope, see Scope
+)
+
+// Condition is an extra condition a row must match
+type Condition struct {
+	Column   string
+	Operator Operator
+	// Value is the value the column is compared with for the Equal and NotEqual operators
+	Value any
+	// Raw and Args contain the condition and args returned by a scope for the Raw operator
+	Raw  string
+	Args []any
+}
+
+// valueKey formats a value so values of different types that a database considers equal, like int 3 and int64 3, get the same key
+func valueKey(value any) string {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.String:
+		return v.String()
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes())
+		}
+	}
+
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,227 @@
+package dbrules
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	. "github.com/mjarkk/laravalidate"
+)
+
+// batchValue is a distinct value of the elements of a list
+type batchValue struct {
+	key     string
+	value   any
+	indexes []int
+}
+
+// ExistsBatch checks that the values of all elements of a list exist using a single lookup
+// It has the same args as Exists, lists it can't handle are validated per element by Exists
+func (r *Rules) ExistsBatch(ctx *ValidatorCtx) bool {
+	if len(ctx.Args) == 0 || ctx.Args[0] == "" {
+		return false
+	}
+
+	table := ctx.Args[0]
+	if r.checkTable(table) != "" {
+		return false
+	}
+
+	column := "id"
+	if len(ctx.Args) >= 2 && ctx.Args[1] != "" {
+		column = ctx.Args[1]
+	}
+	if r.checkColumn(table, column) != "" {
+		return false
+	}
+
+	conditions := []Condition{}
+	if len(ctx.Args) > 2 {
+		if !batchableConditions(ctx.Args[2:]) {
+			return false
+		}
+		var hint string
+		conditions, hint = r.whereConditions(ctx, table, ctx.Args[2:], conditions)
+		if hint != "" {
+			return false
+		}
+	}
+
+	values, ok := batchValues(ctx)
+	if !ok {
+		return false
+	}
+	if len(values) == 0 {
+		return true
+	}
+
+	found, err := r.lookup(ctx.Context(), table, column, batchArgs(values), conditions)
+	if err != nil {
+		ctx.Abort(fmt.Errorf("dbrules: exists query failed: %w", err))
+		return true
+	}
+
+	for idx, value := range values {
+		if found[idx] {
+			continue
+		}
+		for _, elementIdx := range value.indexes {
+			ctx.ElementError(elementIdx, "exists")
+		}
+	}
+
+	return true
+}
+
+// UniqueBatch checks that the values of all elements of a list do not exist yet using a single lookup
+// It has the same args as Unique, lists it can't handle are validated per element by Unique
+func (r *Rules) UniqueBatch(ctx *ValidatorCtx) bool {
+	if len(ctx.Args) == 0 || ctx.Args[0] == "" {
+		return false
+	}
+
+	table := ctx.Args[0]
+	if r.checkTable(table) != "" {
+		return false
+	}
+
+	column := ""
+	if len(ctx.Args) >= 2 && ctx.Args[1] != "" {
+		column = ctx.Args[1]
+	} else {
+		column = fieldColumn(ctx)
+		if column == "" {
+			return false
+		}
+	}
+	if r.checkColumn(table, column) != "" {
+		return false
+	}
+
+	conditions := []Condition{}
+
+	if len(ctx.Args) >= 3 {
+		if strings.HasPrefix(ctx.Args[2], ".") {
+			// The ignored row depends on the element
+			return false
+		}
+
+		ignoreValue, ok := argValue(ctx, ctx.Args[2])
+		if ok {
+			ignoreColumn := "id"
+			if len(ctx.Args) >= 4 && ctx.Args[3] != "" {
+				ignoreColumn = ctx.Args[3]
+			}
+			if r.checkColumn(table, ignoreColumn) != "" {
+				return false
+			}
+
+			conditions = append(conditions, Condition{Column: ignoreColumn, Operator: NotEqual, Value: ignoreValue})
+		}
+	}
+
+	if len(ctx.Args) > 4 {
+		if !batchableConditions(ctx.Args[4:]) {
+			return false
+		}
+		var hint string
+		conditions, hint = r.whereConditions(ctx, table, ctx.Args[4:], conditions)
+		if hint != "" {
+			return false
+		}
+	}
+
+	values, ok := batchValues(ctx)
+	if !ok {
+		return false
+	}
+	if len(values) == 0 {
+		return true
+	}
+
+	found, err := r.lookup(ctx.Context(), table, column, batchArgs(values), conditions)
+	if err != nil {
+		ctx.Abort(fmt.Errorf("dbrules: unique query failed: %w", err))
+		return true
+	}
+
+	for idx, value := range values {
+		if !found[idx] {
+			continue
+		}
+		for _, elementIdx := range value.indexes {
+			ctx.ElementError(elementIdx, "unique")
+		}
+	}
+
+	return true
+}
+
+// batchableConditions returns false if the conditions depend on the element under validation,
+// this is the case for scopes and values referring to other fields
+func batchableConditions(conditions []string) bool {
+	for _, condition := range conditions {
+		if strings.HasPrefix(condition, "scope=") || strings.HasPrefix(strings.TrimPrefix(condition, "!"), ".") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// batchValues returns the distinct values of the elements of the list under validation, nil elements are skipped
+// ok is false if an element is not an integer or string, other values can't reliably be matched with the rows returned by the database
+func batchValues(ctx *ValidatorCtx) (values []*batchValue, ok bool) {
+	if !ctx.HasValue() || !ctx.IsList() {
+		return nil, false
+	}
+
+	byKey := map[string]*batchValue{}
+	for idx := 0; idx < ctx.Value.Len(); idx++ {
+		element := ctx.Value.Index(idx)
+		for element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface {
+			if element.IsNil() {
+				break
+			}
+			element = element.Elem()
+		}
+
+		var key string
+		switch element.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			continue
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			key = strconv.FormatInt(element.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			key = strconv.FormatUint(element.Uint(), 10)
+		case reflect.String:
+			key = element.String()
+		default:
+			return nil, false
+		}
+
+		if !element.CanInterface() {
+			return nil, false
+		}
+
+		value, ok := byKey[key]
+		if !ok {
+			value = &batchValue{key: key, value: element.Interface()}
+			byKey[key] = value
+			values = append(values, value)
+		}
+		value.indexes = append(value.indexes, idx)
+	}
+
+	return values, true
+}
+
+// batchArgs returns the values to look up
+func batchArgs(values []*batchValue) []any {
+	args := make([]any, len(values))
+	for idx, value := range values {
+		args[idx] = value.value
+	}
+	return args
+}
@@ -1,71 +1,168 @@
 package dbrules
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"strconv"
 	"strings"
 
 	. "github.com/mjarkk/laravalidate"
 )
 
-type DB struct {
-	conn          *sql.DB
-	variableStyle QueryVariableStyle
+// Rules contains the exists and unique rules, the lookups are done by the backend
+type Rules struct {
+	backend Backend
+	// allowed contains the allowed tables and their allowed columns, nil if all tables are allowed
+	allowed map[string][]string
+	// scopes contains the named scopes that can be used in the rules, see WithScope
+	scopes map[string]Scope
 }
 
-type QueryVariableStyle uint8
-
-const (
-	DefaultStyle QueryVariableStyle = iota // ?
-	PgStyle                                // $1, $2, ...
-)
+type config struct {
+	quoteStyle QuoteStyle
+	allowed    map[string][]string
+	instance   *Instance
+	scopes     map[string]Scope
+}
 
-func AddRules(conn *sql.DB, variableStyle QueryVariableStyle) {
-	if conn == nil {
-		panic("DB connection cannot be nil")
+func newConfig(options []Option) config {
+	c := config{instance: Default(), scopes: map[string]Scope{}}
+	for _, option := range options {
+		option(&c)
 	}
+	return c
+}
 
-	db := &DB{conn, variableStyle}
+// Option configures the database rules
+type Option func(c *config)
 
-	RegisterValidator("exists", db.Exists)
+// WithQuoteStyle quotes table and column names using the quote style of the database
+// By default names are not quoted
+func WithQuoteStyle(style QuoteStyle) Option {
+	return func(c *config) {
+		c.quoteStyle = style
+	}
+}
 
-	BaseRegisterMessages(map[string]MessageResolver{
-		"exists": BasicMessageResolver("The selected :attribute is invalid."),
-	})
+// WithAllowedTables only allows the rules to query the given tables and columns
+// The keys are the table names and the values the allowed columns, if no columns are given all columns of the table are allowed.
+// Rules using other tables or columns fail with the not_allowed hint
+func WithAllowedTables(allowed map[string][]string) Option {
+	return func(c *config) {
+		c.allowed = allowed
+	}
+}
 
-	LogValidatorsWithoutMessages()
+// WithInstance registers the rules on the given instance instead of the default instance, see laravalidate.New
+func WithInstance(instance *Instance) Option {
+	return func(c *config) {
+		if instance != nil {
+			c.instance = instance
+		}
+	}
 }
 
-func (b *DB) prepareQuery(in string) string {
-	switch b.variableStyle {
-	case PgStyle:
-		for i := 1; i <= strings.Count(in, "?"); i++ {
-			iStr := "$" + strconv.Itoa(i)
-			in = strings.Replace(in, "?", iStr, 1)
+// Scope adds a condition to the queries of the exists and unique rules for constraints that cannot be expressed in a tag
+// The condition should use ? placeholders for the args, for example "team_id = ?"
+// If the condition is empty no condition is added
+type Scope func(ctx *ValidatorCtx) (condition string, args []any)
+
+// WithScope adds a named scope that can be used in the exists and unique rules using scope=name
+func WithScope(name string, scope Scope) Option {
+	return func(c *config) {
+		if scope != nil {
+			c.scopes[name] = scope
 		}
 	}
-	return in
 }
 
-func (b *DB) query(query string, args ...any) (*sql.Rows, error) {
-	return b.conn.Query(b.prepareQuery(query), args...)
+// AddRules registers the exists and unique rules using a sql database as backend, see NewDB and AddBackendRules
+func AddRules(conn *sql.DB, variableStyle QueryVariableStyle, options ...Option) {
+	AddBackendRules(NewDB(conn, variableStyle, options...), options...)
 }
 
-func (b *DB) Exists(ctx *ValidatorCtx) (string, bool) {
+// AddBackendRules registers the exists and unique rules using a custom backend
+//
+// Table and column names from the rule args are always validated to only contain letters, digits, underscores and dollar signs,
+// invalid names fail with the invalid_identifier hint.
+//
+// For validateInner rules on lists of integers or strings the values of all elements are looked up at once, see ExistsBatch and UniqueBatch.
+func AddBackendRules(backend Backend, options ...Option) {
+	if backend == nil {
+		panic("Backend cannot be nil")
+	}
+
+	config := newConfig(options)
+	rules := &Rules{backend: backend, allowed: config.allowed, scopes: config.scopes}
+
+	instance := config.instance
+	instance.RegisterValidator("exists", rules.Exists)
+	instance.RegisterValidator("unique", rules.Unique)
+	instance.RegisterBatchValidator("exists", rules.ExistsBatch)
+	instance.RegisterBatchValidator("unique", rules.UniqueBatch)
+	instance.RegisterArgCount("exists", 1, -1)
+	instance.RegisterArgCount("unique", 1, -1)
+
+	// The invalid_identifier and not_allowed hints are configuration errors of the rule, not of the input
+	instance.BaseRegisterMessages(map[string]MessageResolver{
+		"exists": MessageHintResolver{
+			Fallback: "The selected :attribute is invalid.",
+			Hints: map[string]string{
+				"invalid_identifier": "The :attribute field cannot be validated, the rule uses an invalid table or column name.",
+				"not_allowed":        "The :attribute field cannot be validated, the rule uses a table or column that is not allowed.",
+			},
+		},
+		"unique": MessageHintResolver{
+			Fallback: "The :attribute has already been taken.",
+			Hints: map[string]string{
+				"invalid_identifier": "The :attribute field cannot be validated, the rule uses an invalid table or column name.",
+				"not_allowed":        "The :attribute field cannot be validated, the rule uses a table or column that is not allowed.",
+			},
+		},
+	})
+
+	instance.LogValidatorsWithoutMessages()
+}
+
+// lookup calls the backend and makes sure it returned a result for every value
+func (r *Rules) lookup(ctx context.Context, table string, column string, values []any, conditions []Condition) ([]bool, error) {
+	found, err := r.backend.Exists(ctx, table, column, values, conditions)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(values) {
+		return nil, fmt.Errorf("dbrules: backend returned %d results for %d values", len(found), len(values))
+	}
+	return found, nil
+}
+
+// Exists checks that the value exists in the database
+//
+// Args: table, column, conditions...
+//   - column defaults to id
+//   - conditions are extra where conditions, see whereConditions
+func (r *Rules) Exists(ctx *ValidatorCtx) (string, bool) {
 	if len(ctx.Args) == 0 {
 		return "args", false
 	}
 
-	tableName := ctx.Args[0]
-	if tableName == "" {
+	table := ctx.Args[0]
+	if table == "" {
 		return "args", false
 	}
+	hint := r.checkTable(table)
+	if hint != "" {
+		return hint, false
+	}
 
 	column := "id"
 	if len(ctx.Args) >= 2 && ctx.Args[1] != "" {
 		column = ctx.Args[1]
 	}
+	hint = r.checkColumn(table, column)
+	if hint != "" {
+		return hint, false
+	}
 
 	ctx.UnwrapPointer()
 
@@ -73,25 +170,215 @@ func (b *DB) Exists(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ? LIMIT 1", column, tableName, column)
-	result, err := b.query(
-		query,
-		ctx.Value.Interface(),
-	)
+	conditions := []Condition{}
+	if len(ctx.Args) > 2 {
+		conditions, hint = r.whereConditions(ctx, table, ctx.Args[2:], conditions)
+		if hint != "" {
+			return hint, false
+		}
+	}
+
+	found, err := r.lookup(ctx.Context(), table, column, []any{ctx.Value.Interface()}, conditions)
 	if err != nil {
+		ctx.Abort(fmt.Errorf("dbrules: exists query failed: %w", err))
 		return "exists", false
 	}
+	if !found[0] {
+		return "exists", false
+	}
+
+	return "", true
+}
 
-	defer result.Close()
-	for result.Next() {
-		resp := sql.RawBytes{}
-		err := result.Scan(&resp)
-		if err != nil {
-			return "exists", false
+// Unique checks that the value does not exist yet in the database
+//
+// Args: table, column, ignoreValue, ignoreColumn, conditions...
+//   - column defaults to the json name of the field under validation
+//   - ignoreValue is the value of ignoreColumn of the row to ignore, this is usually the id of the row that is being updated.
+//     If the value starts with a . it is a path to another field (see (*ValidatorCtx).Field), otherwise it is used as is.
+//     If the value is empty, NULL or refers to a nil field no row is ignored.
+//   - ignoreColumn defaults to id
+//   - conditions are extra where conditions after the ignoreColumn, see whereConditions
+func (r *Rules) Unique(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "args", false
+	}
+
+	table := ctx.Args[0]
+	if table == "" {
+		return "args", false
+	}
+	hint := r.checkTable(table)
+	if hint != "" {
+		return hint, false
+	}
+
+	column := ""
+	if len(ctx.Args) >= 2 && ctx.Args[1] != "" {
+		column = ctx.Args[1]
+	} else {
+		column = fieldColumn(ctx)
+		if column == "" {
+			return "args", false
 		}
+	}
+	hint = r.checkColumn(table, column)
+	if hint != "" {
+		return hint, false
+	}
+
+	ctx.UnwrapPointer()
 
+	if !ctx.HasValue() {
 		return "", true
 	}
 
-	return "exists", false
+	conditions := []Condition{}
+
+	if len(ctx.Args) >= 3 {
+		ignoreValue, ok := argValue(ctx, ctx.Args[2])
+		if ok {
+			ignoreColumn := "id"
+			if len(ctx.Args) >= 4 && ctx.Args[3] != "" {
+				ignoreColumn = ctx.Args[3]
+			}
+			hint := r.checkColumn(table, ignoreColumn)
+			if hint != "" {
+				return hint, false
+			}
+
+			conditions = append(conditions, Condition{Column: ignoreColumn, Operator: NotEqual, Value: ignoreValue})
+		}
+	}
+
+	if len(ctx.Args) > 4 {
+		conditions, hint = r.whereConditions(ctx, table, ctx.Args[4:], conditions)
+		if hint != "" {
+			return hint, false
+		}
+	}
+
+	found, err := r.lookup(ctx.Context(), table, column, []any{ctx.Value.Interface()}, conditions)
+	if err != nil {
+		ctx.Abort(fmt.Errorf("dbrules: unique query failed: %w", err))
+		return "unique", false
+	}
+	if found[0] {
+		return "unique", false
+	}
+
+	return "", true
+}
+
+// whereConditions parses the extra conditions of the exists and unique rules and appends them to conditions
+//
+// Conditions are pairs of a column and a value:
+//   - "deleted_at,NULL" = deleted_at IS NULL
+//   - "deleted_at,NOT_NULL" = deleted_at IS NOT NULL
+//   - "status,active" = status = 'active'
+//   - "status,!archived" = status <> 'archived'
+//   - "team_id,.TeamID" = team_id = the value of the TeamID field, see argValue
+//
+// A scope added using WithScope can be used with "scope=name".
+// The returned hint is set if a condition is incomplete, a scope is unknown or a column is invalid
+func (r *Rules) whereConditions(ctx *ValidatorCtx, table string, args []string, conditions []Condition) ([]Condition, string) {
+	for idx := 0; idx < len(args); idx++ {
+		arg := args[idx]
+
+		scopeName, isScope := strings.CutPrefix(arg, "scope=")
+		if isScope {
+			scope, ok := r.scopes[scopeName]
+			if !ok {
+				return nil, "args"
+			}
+
+			scopeCondition, scopeArgs := scope(ctx)
+			if scopeCondition != "" {
+				conditions = append(conditions, Condition{Operator: Raw, Raw: scopeCondition, Args: scopeArgs})
+			}
+			continue
+		}
+
+		idx++
+		if arg == "" || idx >= len(args) {
+			return nil, "args"
+		}
+		column := arg
+		hint := r.checkColumn(table, column)
+		if hint != "" {
+			return nil, hint
+		}
+		value := args[idx]
+
+		switch value {
+		case "NULL":
+			conditions = append(conditions, Condition{Column: column, Operator: IsNull})
+			continue
+		case "NOT_NULL":
+			conditions = append(conditions, Condition{Column: column, Operator: IsNotNull})
+			continue
+		}
+
+		operator := Equal
+		if strings.HasPrefix(value, "!") {
+			operator = NotEqual
+			value = value[1:]
+		}
+
+		var resolved any = value
+		ok := true
+		if value != "" {
+			resolved, ok = argValue(ctx, value)
+		}
+		if !ok {
+			// Comparing with NULL never matches so we use the IS (NOT) NULL variant instead
+			if operator == Equal {
+				conditions = append(conditions, Condition{Column: column, Operator: IsNull})
+			} else {
+				conditions = append(conditions, Condition{Column: column, Operator: IsNotNull})
+			}
+			continue
+		}
+
+		conditions = append(conditions, Condition{Column: column, Operator: operator, Value: resolved})
+	}
+
+	return conditions, ""
+}
+
+// fieldColumn returns the json name of the field under validation, this is used as default column name
+func fieldColumn(ctx *ValidatorCtx) string {
+	stack := ctx.Stack()
+	for idx := len(stack) - 1; idx >= 0; idx-- {
+		if stack[idx].Kind == StackKindObject {
+			return stack[idx].JsonName
+		}
+	}
+
+	return ""
+}
+
+// argValue resolves a value argument of a rule
+// Arguments starting with a . are paths to other fields, other arguments are used as is
+// ok is false if the argument is empty, NULL or refers to a field without value
+func argValue(ctx *ValidatorCtx, arg string) (value any, ok bool) {
+	if arg == "" || arg == "NULL" {
+		return nil, false
+	}
+
+	if arg[0] != '.' {
+		return arg, true
+	}
+
+	field := ctx.Field(arg)
+	if field == nil {
+		return nil, false
+	}
+
+	field.UnwrapPointer()
+	if !field.HasValue() || !field.Value.CanInterface() {
+		return nil, false
+	}
+
+	return field.Value.Interface(), true
 }
@@ -0,0 +1,168 @@
+package dbrules
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareQuery(t *testing.T) {
+	query := "SELECT id FROM users WHERE email = ? AND id <> ? LIMIT 1"
+
+	assert.Equal(t, query, (&DB{variableStyle: DefaultStyle}).prepareQuery(query))
+	assert.Equal(t, "SELECT id FROM users WHERE email = $1 AND id <> $2 LIMIT 1", (&DB{variableStyle: PgStyle}).prepareQuery(query))
+	assert.Equal(t, "SELECT id FROM users WHERE email = @p1 AND id <> @p2 LIMIT 1", (&DB{variableStyle: SqlServerStyle}).prepareQuery(query))
+	assert.Equal(t, "SELECT id FROM users WHERE email = :1 AND id <> :2 LIMIT 1", (&DB{variableStyle: OracleStyle}).prepareQuery(query))
+
+	// Question marks within quoted strings and identifiers are not placeholders
+	query = `SELECT id FROM users WHERE email = ? AND (name <> 'who?' AND "odd?column" = 'it''s ?') AND id <> ?`
+	assert.Equal(t, `SELECT id FROM users WHERE email = $1 AND (name <> 'who?' AND "odd?column" = 'it''s ?') AND id <> $2`, (&DB{variableStyle: PgStyle}).prepareQuery(query))
+}
+
+func TestSelectFirst(t *testing.T) {
+	assert.Equal(t, "SELECT id FROM users WHERE id = ? LIMIT 1", (&DB{variableStyle: PgStyle}).selectFirst("id", "users", "id = ?"))
+	assert.Equal(t, "SELECT TOP 1 id FROM users WHERE id = ?", (&DB{variableStyle: SqlServerStyle}).selectFirst("id", "users", "id = ?"))
+	assert.Equal(t, "SELECT id FROM users WHERE id = ? FETCH FIRST 1 ROWS ONLY", (&DB{variableStyle: OracleStyle}).selectFirst("id", "users", "id = ?"))
+}
+
+func TestBatchQuery(t *testing.T) {
+	assert.Equal(t, "SELECT id FROM products WHERE id IN (?, ?, ?)", batchQuery("id", "products", 3, nil))
+	assert.Equal(t, "SELECT id FROM products WHERE id IN (?) AND deleted_at IS NULL", batchQuery("id", "products", 1, []string{"deleted_at IS NULL"}))
+}
+
+func TestBatchableConditions(t *testing.T) {
+	assert.True(t, batchableConditions([]string{"deleted_at", "NULL", "status", "!archived"}))
+	assert.False(t, batchableConditions([]string{"team_id", ".TeamID"}))
+	assert.False(t, batchableConditions([]string{"team_id", "!.TeamID"}))
+	assert.False(t, batchableConditions([]string{"scope=team"}))
+}
+
+func TestWhere(t *testing.T) {
+	db := &DB{quoteStyle: DoubleQuotes}
+	where, args, err := db.where([]Condition{
+		{Column: "deleted_at", Operator: IsNull},
+		{Column: "status", Operator: NotEqual, Value: "archived"},
+		{Operator: Raw, Raw: "team_id = ?", Args: []any{4}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`"deleted_at" IS NULL`, `"status" <> ?`, "(team_id = ?)"}, where)
+	assert.Equal(t, []any{"archived", 4}, args)
+
+	_, _, err = db.where([]Condition{{Column: "status; --", Operator: Equal, Value: "a"}})
+	assert.Error(t, err)
+}
+
+// collationDriver is a database/sql driver with a single column table that compares values case insensitive
+// like a database with a case insensitive collation, the rows are returned as stored
+type collationDriver struct {
+	rows    []string
+	queries []string
+}
+
+func (d *collationDriver) Open(name string) (driver.Conn, error) {
+	return &collationConn{d}, nil
+}
+
+type collationConn struct {
+	driver *collationDriver
+}
+
+func (c *collationConn) Prepare(query string) (driver.Stmt, error) {
+	return &collationStmt{c.driver, query}, nil
+}
+
+func (c *collationConn) Close() error {
+	return nil
+}
+
+func (c *collationConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+type collationStmt struct {
+	driver *collationDriver
+	query  string
+}
+
+func (s *collationStmt) Close() error {
+	return nil
+}
+
+func (s *collationStmt) NumInput() int {
+	return -1
+}
+
+func (s *collationStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+
+func (s *collationStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.queries = append(s.driver.queries, s.query)
+
+	rows := &collationRows{}
+	for _, row := range s.driver.rows {
+		for _, arg := range args {
+			if strings.EqualFold(row, fmt.Sprint(arg)) {
+				rows.values = append(rows.values, row)
+				break
+			}
+		}
+	}
+	return rows, nil
+}
+
+type collationRows struct {
+	values []string
+}
+
+func (r *collationRows) Columns() []string {
+	return []string{"name"}
+}
+
+func (r *collationRows) Close() error {
+	return nil
+}
+
+func (r *collationRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0] = []byte(r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestDBExistsBatch(t *testing.T) {
+	fakeDriver := &collationDriver{rows: []string{"Alice", "bob"}}
+	sql.Register("dbrules_collation", fakeDriver)
+	conn, err := sql.Open("dbrules_collation", "")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	db := NewDB(conn, DefaultStyle)
+
+	found, err := db.Exists(context.Background(), "users", "name", []any{"alice", "bob", "carol"}, nil)
+	assert.NoError(t, err)
+	// alice only matches because of the collation so it is looked up again, carol is not returned at all so it is not found without another query
+	assert.Equal(t, []bool{true, true, false}, found)
+	assert.Equal(t, []string{
+		"SELECT name FROM users WHERE name IN (?, ?, ?)",
+		"SELECT name FROM users WHERE name = ? LIMIT 1",
+	}, fakeDriver.queries)
+
+	fakeDriver.queries = nil
+	found, err = db.Exists(context.Background(), "users", "name", []any{"bob", "carol", "dave"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, found)
+	assert.Equal(t, []string{"SELECT name FROM users WHERE name IN (?, ?, ?)"}, fakeDriver.queries)
+
+	found, err = db.Exists(context.Background(), "users", "name", []any{"alice"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, found)
+}
@@ -0,0 +1,101 @@
+package dbrules
+
+import (
+	"strings"
+)
+
+type QuoteStyle uint8
+
+const (
+	NoQuotes       QuoteStyle = iota // name
+	BacktickQuotes                   // `name`, MySQL and MariaDB
+	DoubleQuotes                     // "name", PostgreSQL and SQLite
+	BracketQuotes                    // [name], SQL Server
+)
+
+// validIdentifier checks if a table or column name only contains letters, digits, underscores and dollar signs and does not start with a digit
+// A name may be qualified with a schema using a dot, for example "public.users"
+func validIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, part := range strings.Split(name, ".") {
+		if part == "" {
+			return false
+		}
+
+		for idx, c := range part {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+			case idx > 0 && (c >= '0' && c <= '9' || c == '$'):
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// quote quotes a valid identifier using the quote style of the database
+func (b *DB) quote(name string) string {
+	var open, close string
+	switch b.quoteStyle {
+	case BacktickQuotes:
+		open, close = "`", "`"
+	case DoubleQuotes:
+		open, close = `"`, `"`
+	case BracketQuotes:
+		open, close = "[", "]"
+	default:
+		return name
+	}
+
+	parts := strings.Split(name, ".")
+	for idx, part := range parts {
+		parts[idx] = open + part + close
+	}
+	return strings.Join(parts, ".")
+}
+
+// checkTable returns a hint if the table name is invalid or not within the allow-list
+func (r *Rules) checkTable(name string) string {
+	if !validIdentifier(name) {
+		return "invalid_identifier"
+	}
+
+	if r.allowed != nil {
+		_, ok := r.allowed[name]
+		if !ok {
+			return "not_allowed"
+		}
+	}
+
+	return ""
+}
+
+// checkColumn returns a hint if the column name of a table is invalid or not within the allow-list
+func (r *Rules) checkColumn(table string, name string) string {
+	if !validIdentifier(name) {
+		return "invalid_identifier"
+	}
+
+	if r.allowed != nil {
+		columns := r.allowed[table]
+		if len(columns) > 0 {
+			allowed := false
+			for _, column := range columns {
+				if column == name {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return "not_allowed"
+			}
+		}
+	}
+
+	return ""
+}
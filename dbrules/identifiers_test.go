@@ -0,0 +1,40 @@
+package dbrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidIdentifier(t *testing.T) {
+	for _, name := range []string{"users", "user_emails", "_private", "public.users", "col$1", "Users2"} {
+		assert.Truef(t, validIdentifier(name), "name=%s", name)
+	}
+	for _, name := range []string{"", "1users", "users;", "users--", "users name", "public.", ".users", "`users`", "users)"} {
+		assert.Falsef(t, validIdentifier(name), "name=%s", name)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, "public.users", (&DB{}).quote("public.users"))
+	assert.Equal(t, "`public`.`users`", (&DB{quoteStyle: BacktickQuotes}).quote("public.users"))
+	assert.Equal(t, `"public"."users"`, (&DB{quoteStyle: DoubleQuotes}).quote("public.users"))
+	assert.Equal(t, "[public].[users]", (&DB{quoteStyle: BracketQuotes}).quote("public.users"))
+}
+
+func TestAllowedTables(t *testing.T) {
+	rules := &Rules{
+		allowed: map[string][]string{
+			"users":    {"id", "email"},
+			"projects": nil,
+		},
+	}
+
+	assert.Equal(t, "", rules.checkTable("users"))
+	assert.Equal(t, "not_allowed", rules.checkTable("secrets"))
+	assert.Equal(t, "invalid_identifier", rules.checkTable("users; DROP TABLE users"))
+
+	assert.Equal(t, "", rules.checkColumn("users", "email"))
+	assert.Equal(t, "not_allowed", rules.checkColumn("users", "password"))
+	assert.Equal(t, "", rules.checkColumn("projects", "name"))
+}
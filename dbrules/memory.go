@@ -0,0 +1,87 @@
+package dbrules
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Memory is a Backend that keeps its rows in memory, this is useful for tests and small catalogs that do not live in a database
+// Scopes are not supported as their conditions are SQL, lookups using a scope fail.
+type Memory struct {
+	lock   sync.RWMutex
+	tables map[string][]map[string]any
+}
+
+// NewMemory returns an empty in memory backend
+func NewMemory() *Memory {
+	return &Memory{tables: map[string][]map[string]any{}}
+}
+
+// Insert adds rows to a table, the keys of a row are the column names
+// Missing columns and nil values are treated as NULL
+func (m *Memory) Insert(table string, rows ...map[string]any) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tables[table] = append(m.tables[table], rows...)
+}
+
+func (m *Memory) Exists(ctx context.Context, table string, column string, values []any, conditions []Condition) ([]bool, error) {
+	for _, condition := range conditions {
+		if condition.Operator == Raw {
+			return nil, errors.New("dbrules: the memory backend does not support scopes")
+		}
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := map[string]struct{}{}
+	for _, row := range m.tables[table] {
+		value := row[column]
+		if value == nil || !rowMatches(row, conditions) {
+			continue
+		}
+		keys[valueKey(value)] = struct{}{}
+	}
+
+	found := make([]bool, len(values))
+	for idx, value := range values {
+		if value == nil {
+			continue
+		}
+		_, found[idx] = keys[valueKey(value)]
+	}
+	return found, nil
+}
+
+// rowMatches checks the conditions against a row using the SQL semantics, comparisons with NULL never match
+func rowMatches(row map[string]any, conditions []Condition) bool {
+	for _, condition := range conditions {
+		value := row[condition.Column]
+
+		switch condition.Operator {
+		case IsNull:
+			if value != nil {
+				return false
+			}
+		case IsNotNull:
+			if value == nil {
+				return false
+			}
+		case Equal:
+			if value == nil || condition.Value == nil || valueKey(value) != valueKey(condition.Value) {
+				return false
+			}
+		case NotEqual:
+			if value == nil || condition.Value == nil || valueKey(value) == valueKey(condition.Value) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
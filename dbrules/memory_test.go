@@ -0,0 +1,81 @@
+package dbrules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mjarkk/laravalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	memory := NewMemory()
+	memory.Insert("products",
+		map[string]any{"id": int64(1), "status": "active"},
+		map[string]any{"id": int64(2), "status": "archived"},
+		map[string]any{"id": int64(3), "status": "active", "deleted_at": "2024-01-01"},
+	)
+
+	found, err := memory.Exists(context.Background(), "products", "id", []any{1, uint(2), "3", 4}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true, false}, found)
+
+	found, err = memory.Exists(context.Background(), "products", "id", []any{1, 2, 3}, []Condition{
+		{Column: "deleted_at", Operator: IsNull},
+		{Column: "status", Operator: NotEqual, Value: "archived"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, found)
+
+	found, err = memory.Exists(context.Background(), "users", "id", []any{1}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false}, found)
+
+	_, err = memory.Exists(context.Background(), "products", "id", []any{1}, []Condition{{Operator: Raw, Raw: "team_id = ?"}})
+	assert.Error(t, err)
+}
+
+func TestBackendRules(t *testing.T) {
+	memory := NewMemory()
+	memory.Insert("products",
+		map[string]any{"id": 1},
+		map[string]any{"id": 2},
+		map[string]any{"id": 3, "deleted_at": "2024-01-01"},
+	)
+	memory.Insert("users", map[string]any{"id": 1, "email": "taken@example.com"})
+	instance := laravalidate.New()
+	AddBackendRules(memory, WithInstance(instance))
+
+	type Order struct {
+		ProductID int      `json:"product_id" validate:"exists:products"`
+		Items     []int    `json:"items" validateInner:"exists:products,id,deleted_at,NULL"`
+		Email     string   `json:"email" validate:"unique:users"`
+		Emails    []string `json:"emails" validateInner:"unique:users,email"`
+	}
+
+	assert.NoError(t, instance.Check(Order{}))
+
+	err := instance.JsonValidate(nil, nil, Order{
+		ProductID: 1,
+		Items:     []int{1, 2, 1},
+		Email:     "new@example.com",
+		Emails:    []string{"other@example.com"},
+	})
+	assert.NoError(t, err)
+
+	err = instance.JsonValidate(nil, nil, Order{
+		ProductID: 4,
+		Items:     []int{1, 3, 2, 4, 3},
+		Email:     "taken@example.com",
+		Emails:    []string{"other@example.com", "taken@example.com"},
+	})
+	var validationErr *laravalidate.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+
+	paths := []string{}
+	for _, fieldErr := range validationErr.Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	assert.Equal(t, []string{"product_id", "items.1", "items.3", "items.4", "email", "emails.1"}, paths)
+}
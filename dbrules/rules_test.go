@@ -0,0 +1,184 @@
+package dbrules
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mjarkk/laravalidate"
+	"github.com/stretchr/testify/assert"
+)
+
+// lookup is a call to the recordingBackend
+type lookup struct {
+	table      string
+	column     string
+	values     []any
+	conditions []Condition
+}
+
+// recordingBackend records the lookups and reports every value as found if found is set
+type recordingBackend struct {
+	found   bool
+	lookups []lookup
+}
+
+func (b *recordingBackend) Exists(ctx context.Context, table string, column string, values []any, conditions []Condition) ([]bool, error) {
+	b.lookups = append(b.lookups, lookup{table, column, values, conditions})
+	found := make([]bool, len(values))
+	for idx := range found {
+		found[idx] = b.found
+	}
+	return found, nil
+}
+
+func newRecordingRules(found bool, options ...Option) (*laravalidate.Instance, *recordingBackend) {
+	instance := laravalidate.New()
+	backend := &recordingBackend{found: found}
+	AddBackendRules(backend, append(options, WithInstance(instance))...)
+	return instance, backend
+}
+
+func TestUnique(t *testing.T) {
+	instance, backend := newRecordingRules(false)
+
+	type Body struct {
+		ID       int     `json:"id"`
+		OwnerID  *int    `json:"owner_id"`
+		Email    string  `json:"email_address" validate:"unique:users"`
+		Username string  `json:"username" validate:"unique:users,name,.ID"`
+		Slug     string  `json:"slug" validate:"unique:posts,slug,.OwnerID,owner_id"`
+		Code     string  `json:"code" validate:"unique:coupons,code,NULL"`
+		Handle   string  `json:"handle" validate:"unique:users,handle,"`
+		Static   string  `json:"static" validate:"unique:users,static,5,uuid"`
+		Optional *string `json:"optional" validate:"unique:users"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Body{ID: 7, Email: "a@example.com", Username: "john", Slug: "hello", Code: "c", Handle: "h", Static: "s"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []lookup{
+		// The column defaults to the json name of the field
+		{"users", "email_address", []any{"a@example.com"}, []Condition{}},
+		// The ignored row is resolved from another field
+		{"users", "name", []any{"john"}, []Condition{{Column: "id", Operator: NotEqual, Value: 7}}},
+		// A nil field, NULL or an empty value do not ignore a row
+		{"posts", "slug", []any{"hello"}, []Condition{}},
+		{"coupons", "code", []any{"c"}, []Condition{}},
+		{"users", "handle", []any{"h"}, []Condition{}},
+		{"users", "static", []any{"s"}, []Condition{{Column: "uuid", Operator: NotEqual, Value: "5"}}},
+	}, backend.lookups)
+
+	instance, _ = newRecordingRules(true)
+	err = instance.JsonValidate(nil, nil, struct {
+		Email string `json:"email" validate:"unique:users"`
+	}{Email: "taken@example.com"})
+	assert.EqualError(t, err, "The email has already been taken.")
+}
+
+func TestFieldColumn(t *testing.T) {
+	instance, backend := newRecordingRules(false)
+
+	type Item struct {
+		Sku string `json:"sku_code" validate:"unique:products"`
+	}
+	type Body struct {
+		Items []Item            `json:"items"`
+		Tags  map[string]string `json:"tag_names" validateInner:"unique:tags"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Body{Items: []Item{{Sku: "a"}}, Tags: map[string]string{"x": "b"}})
+	assert.NoError(t, err)
+
+	// List indexes and map keys are skipped when looking for the name of the field
+	assert.Equal(t, "sku_code", backend.lookups[0].column)
+	assert.Equal(t, "tag_names", backend.lookups[1].column)
+}
+
+func TestWhereConditions(t *testing.T) {
+	instance, backend := newRecordingRules(true, WithScope("current_team", func(ctx *laravalidate.ValidatorCtx) (string, []any) {
+		return "team_id = ?", []any{42}
+	}))
+
+	type Body struct {
+		TeamID    *int `json:"team_id"`
+		OwnerID   int  `json:"owner_id"`
+		ProjectID int  `json:"project_id" validate:"exists:projects,id,deleted_at,NULL,archived_at,NOT_NULL,status,active,type,!internal"`
+		OwnedID   int  `json:"owned_id" validate:"exists:projects,id,owner_id,.OwnerID,team_id,.TeamID,parent_id,!.TeamID"`
+		ScopedID  int  `json:"scoped_id" validate:"exists:projects,id,scope=current_team"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Body{OwnerID: 3, ProjectID: 1, OwnedID: 2, ScopedID: 4})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []lookup{
+		{"projects", "id", []any{1}, []Condition{
+			{Column: "deleted_at", Operator: IsNull},
+			{Column: "archived_at", Operator: IsNotNull},
+			{Column: "status", Operator: Equal, Value: "active"},
+			{Column: "type", Operator: NotEqual, Value: "internal"},
+		}},
+		// Fields without value are compared using IS (NOT) NULL
+		{"projects", "id", []any{2}, []Condition{
+			{Column: "owner_id", Operator: Equal, Value: 3},
+			{Column: "team_id", Operator: IsNull},
+			{Column: "parent_id", Operator: IsNotNull},
+		}},
+		{"projects", "id", []any{4}, []Condition{
+			{Operator: Raw, Raw: "team_id = ?", Args: []any{42}},
+		}},
+	}, backend.lookups)
+
+	// Incomplete conditions and unknown scopes fail without a lookup
+	for _, rule := range []string{"exists:projects,id,status", "exists:projects,id,,active", "exists:projects,id,scope=unknown"} {
+		backend.lookups = nil
+		field := reflect.StructField{Name: "ID", Type: reflect.TypeOf(0), Tag: reflect.StructTag(`json:"id" validate:"` + rule + `"`)}
+		value := reflect.New(reflect.StructOf([]reflect.StructField{field})).Elem()
+		value.Field(0).SetInt(1)
+
+		err = instance.JsonValidate(nil, nil, value.Interface())
+		assert.Error(t, err, rule)
+		assert.Empty(t, backend.lookups, rule)
+	}
+}
+
+func TestScopesPerInstance(t *testing.T) {
+	scope := func(ctx *laravalidate.ValidatorCtx) (string, []any) {
+		return "team_id = 1", nil
+	}
+	withScope, withScopeBackend := newRecordingRules(true, WithScope("team", scope))
+	withoutScope, withoutScopeBackend := newRecordingRules(true)
+
+	type Body struct {
+		ID int `json:"id" validate:"exists:projects,id,scope=team"`
+	}
+
+	assert.NoError(t, withScope.JsonValidate(nil, nil, Body{ID: 1}))
+	assert.Len(t, withScopeBackend.lookups, 1)
+
+	// Scopes of other rules are unknown
+	assert.Error(t, withoutScope.JsonValidate(nil, nil, Body{ID: 1}))
+	assert.Empty(t, withoutScopeBackend.lookups)
+}
+
+func TestConfigurationErrorMessages(t *testing.T) {
+	instance, backend := newRecordingRules(false, WithAllowedTables(map[string][]string{"users": {"id", "email"}}))
+
+	type Body struct {
+		TeamID   int    `json:"team_id" validate:"exists:teams"`
+		Email    string `json:"email" validate:"unique:users,password"`
+		Username string `json:"username" validate:"unique:users;--"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Body{TeamID: 1, Email: "john@example.org", Username: "john"})
+	typedErr, ok := err.(*laravalidate.ValidationError)
+	if assert.True(t, ok) && assert.Len(t, typedErr.Errors, 3) {
+		assert.Equal(t, "not_allowed", typedErr.Errors[0].Errors[0].Hint)
+		assert.Equal(t, "The team_id field cannot be validated, the rule uses a table or column that is not allowed.", typedErr.Errors[0].Errors[0].Message)
+		assert.Equal(t, "not_allowed", typedErr.Errors[1].Errors[0].Hint)
+		assert.Equal(t, "The email field cannot be validated, the rule uses a table or column that is not allowed.", typedErr.Errors[1].Errors[0].Message)
+		assert.Equal(t, "invalid_identifier", typedErr.Errors[2].Errors[0].Hint)
+		assert.Equal(t, "The username field cannot be validated, the rule uses an invalid table or column name.", typedErr.Errors[2].Errors[0].Message)
+	}
+	assert.Empty(t, backend.lookups)
+}
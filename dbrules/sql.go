@@ -0,0 +1,259 @@
+package dbrules
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DB is the database/sql Backend
+type DB struct {
+	conn          *sql.DB
+	variableStyle QueryVariableStyle
+	quoteStyle    QuoteStyle
+}
+
+type QueryVariableStyle uint8
+
+const (
+	DefaultStyle   QueryVariableStyle = iota // ?
+	PgStyle                                  // $1, $2, ...
+	SqlServerStyle                           // @p1, @p2, ...
+	OracleStyle                              // :1, :2, ...
+)
+
+// maxBatchSize is the maximum amount of values within a single IN (..), Oracle does not allow more than 1000
+const maxBatchSize = 1000
+
+// NewDB returns a Backend that queries a sql database
+// Of the options only WithQuoteStyle applies to the backend
+func NewDB(conn *sql.DB, variableStyle QueryVariableStyle, options ...Option) *DB {
+	if conn == nil {
+		panic("DB connection cannot be nil")
+	}
+
+	config := newConfig(options)
+	return &DB{conn: conn, variableStyle: variableStyle, quoteStyle: config.quoteStyle}
+}
+
+// Exists looks up a single value using a query that selects the first matching row,
+// multiple values are looked up using WHERE column IN (..) queries of at most maxBatchSize values.
+//
+// The rows returned by an IN query are matched with the values by their exact string representation.
+// Values that only match a returned row after normalizing, see normalizedKey, might match because of a collation or padding,
+// these are looked up again using the single value query so the database decides. Other values are not found.
+func (b *DB) Exists(ctx context.Context, table string, column string, values []any, conditions []Condition) ([]bool, error) {
+	if !validIdentifier(table) || !validIdentifier(column) {
+		return nil, errors.New("dbrules: invalid table or column name")
+	}
+	table = b.quote(table)
+	column = b.quote(column)
+
+	where, whereArgs, err := b.where(conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]bool, len(values))
+	singleQuery := b.selectFirst(column, table, strings.Join(append([]string{column + " = ?"}, where...), " AND "))
+	if len(values) == 1 {
+		found[0], err = b.rowExists(ctx, singleQuery, append([]any{values[0]}, whereArgs...)...)
+		return found, err
+	}
+
+	keys := map[string]struct{}{}
+	for start := 0; start < len(values); start += maxBatchSize {
+		chunk := values[start:min(start+maxBatchSize, len(values))]
+
+		args := make([]any, 0, len(chunk)+len(whereArgs))
+		args = append(args, chunk...)
+		args = append(args, whereArgs...)
+
+		err = b.scanValues(ctx, batchQuery(column, table, len(chunk), where), args, keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	normalizedKeys := map[string]struct{}{}
+	for key := range keys {
+		normalizedKeys[normalizedKey(key)] = struct{}{}
+	}
+
+	for idx, value := range values {
+		key := valueKey(value)
+		_, found[idx] = keys[key]
+		if found[idx] {
+			continue
+		}
+		if _, ambiguous := normalizedKeys[normalizedKey(key)]; !ambiguous {
+			continue
+		}
+
+		found[idx], err = b.rowExists(ctx, singleQuery, append([]any{value}, whereArgs...)...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// normalizedKey case folds the key and removes trailing spaces
+// Values with the same normalized key might be equal for the database because of a case insensitive collation or PAD SPACE comparisons
+func normalizedKey(key string) string {
+	return strings.ToLower(strings.TrimRight(key, " "))
+}
+
+// where converts the conditions into sql conditions and their args
+func (b *DB) where(conditions []Condition) ([]string, []any, error) {
+	where := []string{}
+	args := []any{}
+
+	for _, condition := range conditions {
+		if condition.Operator == Raw {
+			where = append(where, "("+condition.Raw+")")
+			args = append(args, condition.Args...)
+			continue
+		}
+
+		if !validIdentifier(condition.Column) {
+			return nil, nil, errors.New("dbrules: invalid column name")
+		}
+		column := b.quote(condition.Column)
+
+		switch condition.Operator {
+		case Equal:
+			where = append(where, column+" = ?")
+			args = append(args, condition.Value)
+		case NotEqual:
+			where = append(where, column+" <> ?")
+			args = append(args, condition.Value)
+		case IsNull:
+			where = append(where, column+" IS NULL")
+		case IsNotNull:
+			where = append(where, column+" IS NOT NULL")
+		default:
+			return nil, nil, fmt.Errorf("dbrules: unknown operator %d", condition.Operator)
+		}
+	}
+
+	return where, args, nil
+}
+
+// prepareQuery replaces the ? placeholders with the placeholders of the variable style
+// Question marks within quoted strings and identifiers are kept as is,
+// other question marks like the jsonb ? operator of PostgreSQL are always replaced so scopes should use jsonb_exists instead.
+func (b *DB) prepareQuery(in string) string {
+	var prefix string
+	switch b.variableStyle {
+	case PgStyle:
+		prefix = "$"
+	case SqlServerStyle:
+		prefix = "@p"
+	case OracleStyle:
+		prefix = ":"
+	default:
+		return in
+	}
+
+	out := strings.Builder{}
+	i := 0
+	// quote is the quote character of the literal we are in, 0 if we are not in a literal
+	// Escaped quotes ('' or "") close and reopen the literal so they do not need special handling
+	var quote rune
+	for _, c := range in {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			i++
+			out.WriteString(prefix)
+			out.WriteString(strconv.Itoa(i))
+			continue
+		}
+
+		out.WriteRune(c)
+	}
+	return out.String()
+}
+
+// selectFirst builds a query that selects the column of the first row matching the where clause
+// SQL Server and Oracle do not support LIMIT so the query depends on the variable style of the database
+func (b *DB) selectFirst(column string, table string, where string) string {
+	switch b.variableStyle {
+	case SqlServerStyle:
+		return fmt.Sprintf("SELECT TOP 1 %s FROM %s WHERE %s", column, table, where)
+	case OracleStyle:
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s FETCH FIRST 1 ROWS ONLY", column, table, where)
+	default:
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s LIMIT 1", column, table, where)
+	}
+}
+
+// batchQuery builds a query that selects the column of all rows where the column is one of the values
+// The placeholders of the values are placed before the placeholders of the where conditions
+func batchQuery(column string, table string, valuesCount int, where []string) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", valuesCount), ", ")
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%s)", column, table, column, placeholders)
+	if len(where) > 0 {
+		query += " AND " + strings.Join(where, " AND ")
+	}
+	return query
+}
+
+// query runs a query that is cancelled when the context of the validation is cancelled
+func (b *DB) query(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return b.conn.QueryContext(ctx, b.prepareQuery(query), args...)
+}
+
+// rowExists returns true if the query returns at least one row
+func (b *DB) rowExists(ctx context.Context, query string, args ...any) (bool, error) {
+	result, err := b.query(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	defer result.Close()
+
+	if !result.Next() {
+		return false, result.Err()
+	}
+
+	resp := sql.RawBytes{}
+	err = result.Scan(&resp)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// scanValues adds the string representation of the first column of every returned row to found
+func (b *DB) scanValues(ctx context.Context, query string, args []any, found map[string]struct{}) error {
+	result, err := b.query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer result.Close()
+
+	for result.Next() {
+		value := sql.RawBytes{}
+		err = result.Scan(&value)
+		if err != nil {
+			return err
+		}
+		found[string(value)] = struct{}{}
+	}
+
+	return result.Err()
+}
@@ -1,6 +1,11 @@
 package laravalidate
 
-import "reflect"
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
 
 // equal is a helper function to compare two reflect.Value
 // This method is similar to reflect.DeepEqual but it's less strict
@@ -120,3 +125,217 @@ func equal(a reflect.Value, b reflect.Value) bool {
 
 	return false
 }
+
+// empty returns true if the needle does not contain a value or contains a value that is considered empty
+// by the required validator, this is a nil value, empty string or an empty slice or map
+func empty(n *Needle) bool {
+	n.UnwrapPointer()
+
+	if !n.HasValue() {
+		return true
+	}
+
+	switch n.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Interface:
+		if n.Value.IsNil() {
+			return true
+		}
+	case reflect.Map, reflect.Slice:
+		if n.Value.IsNil() {
+			return true
+		}
+		if n.Value.Len() == 0 {
+			return true
+		}
+	case reflect.String:
+		if n.Value.String() == "" {
+			return true
+		}
+	case reflect.Struct:
+		// An uploaded file without a name is what browsers send for an empty file input
+		file, status := n.File()
+		if status.Oke() && file.Filename == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equalsArg checks if the value of a needle is equal to a validator argument
+// A nil needle or a needle without value equals "null"
+func equalsArg(n *Needle, arg string) bool {
+	if n == nil {
+		return arg == "null"
+	}
+
+	n.UnwrapPointer()
+	if !n.HasValue() {
+		return arg == "null"
+	}
+
+	value := *n.Value
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return arg == "null"
+		}
+
+		value = value.Elem()
+		return equalsArg(&Needle{Type: value.Type(), Value: &value}, arg)
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String() == arg
+	case reflect.Bool:
+		switch arg {
+		case "true", "1":
+			return value.Bool()
+		case "false", "0":
+			return !value.Bool()
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(arg, 10, 64)
+		return err == nil && value.Int() == parsed
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(arg, 10, 64)
+		return err == nil && value.Uint() == parsed
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(arg, 64)
+		return err == nil && value.Float() == parsed
+	}
+
+	return false
+}
+
+// equalsAnyArg checks if the value of a needle is equal to one of the validator arguments
+func equalsAnyArg(n *Needle, args []string) bool {
+	for _, arg := range args {
+		if equalsArg(n, arg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNil returns true if the needle does not contain a value or if the value is a nil pointer, interface, slice or map
+// Compared to (*Needle).UnwrapPointer this does not modify the needle
+func isNil(n *Needle) bool {
+	if !n.HasValue() {
+		return true
+	}
+
+	value := *n.Value
+	for {
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if value.IsNil() {
+				return true
+			}
+			value = value.Elem()
+		case reflect.Map, reflect.Slice:
+			return value.IsNil()
+		case reflect.Invalid:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// numericWhitespace contains the characters PHP's is_numeric allows around a number
+const numericWhitespace = " \t\n\r\v\f"
+
+// isNumericString mirrors PHP's is_numeric for strings
+// It allows surrounding whitespace, a sign, a decimal point and an exponent, for example " -1.5e3"
+func isNumericString(str string) bool {
+	_, ok := trimNumericString(str)
+	return ok
+}
+
+// trimNumericString returns the number without surrounding whitespace, ok is false if the string is not numeric
+func trimNumericString(str string) (number string, ok bool) {
+	str = strings.Trim(str, numericWhitespace)
+
+	idx := 0
+	if idx < len(str) && (str[idx] == '+' || str[idx] == '-') {
+		idx++
+	}
+
+	digits := 0
+	for idx < len(str) && str[idx] >= '0' && str[idx] <= '9' {
+		idx++
+		digits++
+	}
+	if idx < len(str) && str[idx] == '.' {
+		idx++
+		for idx < len(str) && str[idx] >= '0' && str[idx] <= '9' {
+			idx++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return "", false
+	}
+
+	if idx < len(str) && (str[idx] == 'e' || str[idx] == 'E') {
+		idx++
+		if idx < len(str) && (str[idx] == '+' || str[idx] == '-') {
+			idx++
+		}
+
+		exponentDigits := 0
+		for idx < len(str) && str[idx] >= '0' && str[idx] <= '9' {
+			idx++
+			exponentDigits++
+		}
+		if exponentDigits == 0 {
+			return "", false
+		}
+	}
+
+	return str, idx == len(str)
+}
+
+// numberString returns the decimal representation of a numeric needle
+// Integers and floats are formatted without exponent, strings are returned without surrounding whitespace if they are numeric
+func numberString(n *Needle) (string, ConvertStatus) {
+	switch n.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !n.HasValue() {
+			return "", ValueNil
+		}
+		return strconv.FormatInt(n.Value.Int(), 10), ConverstionOk
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !n.HasValue() {
+			return "", ValueNil
+		}
+		return strconv.FormatUint(n.Value.Uint(), 10), ConverstionOk
+	case reflect.Float32, reflect.Float64:
+		if !n.HasValue() {
+			return "", ValueNil
+		}
+		value := n.Value.Float()
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return "", Invalid
+		}
+		bitSize := 64
+		if n.Kind() == reflect.Float32 {
+			bitSize = 32
+		}
+		return strconv.FormatFloat(value, 'f', -1, bitSize), ConverstionOk
+	case reflect.String:
+		str, status := n.String()
+		if !status.Oke() {
+			return "", status
+		}
+		number, ok := trimNumericString(str)
+		if !ok {
+			return "", Invalid
+		}
+		return number, ConverstionOk
+	default:
+		return "", InvalidType
+	}
+}
@@ -0,0 +1,89 @@
+package laravalidate
+
+import (
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Instance contains a set of rules, their messages and the default languages
+// Instances are isolated from each other, rules and messages registered on one instance are not known by other instances.
+// All methods are safe for concurrent use, rules can be registered while other goroutines are validating.
+//
+// The package level functions like JsonValidate and RegisterValidator use the default instance, see Default.
+type Instance struct {
+	lock       sync.RWMutex
+	validators map[string]registeredValidatorT
+	// plans contains the compiled *structPlan of every validated struct type by their reflect.Type
+	plans sync.Map
+	// languages are used if no languages are given when validating
+	languages                  []language.Tag
+	compromisedPasswordChecker CompromisedPasswordChecker
+	// strict is set if invalid rules should fail the validation instead of being logged, see WithStrict
+	strict bool
+}
+
+// InstanceOption configures an instance, see New
+type InstanceOption func(i *Instance)
+
+// WithLanguages sets the languages used for the error messages if no languages are given when validating
+// By default english is used
+func WithLanguages(languages ...language.Tag) InstanceOption {
+	return func(i *Instance) {
+		i.languages = languages
+	}
+}
+
+// WithCompromisedPasswordChecker sets the checker used by the uncompromised argument of the password rule
+func WithCompromisedPasswordChecker(checker CompromisedPasswordChecker) InstanceOption {
+	return func(i *Instance) {
+		i.compromisedPasswordChecker = checker
+	}
+}
+
+// WithStrict enables strict mode, see (*Instance).SetStrict
+func WithStrict() InstanceOption {
+	return func(i *Instance) {
+		i.strict = true
+	}
+}
+
+// New creates a new instance with the built in rules and their english messages
+// Translations and extra rules like the ones of the dbrules package have to be registered on the instance itself.
+func New(options ...InstanceOption) *Instance {
+	i := &Instance{
+		validators: map[string]registeredValidatorT{},
+	}
+	registerBaseRules(i)
+
+	for _, option := range options {
+		option(i)
+	}
+
+	return i
+}
+
+var defaultInstance = New()
+
+// Default returns the instance used by the package level functions
+func Default() *Instance {
+	return defaultInstance
+}
+
+// SetStrict enables or disables strict mode of the default instance, see (*Instance).SetStrict
+func SetStrict(strict bool) {
+	defaultInstance.SetStrict(strict)
+}
+
+// SetStrict enables or disables strict mode
+// By default invalid rules are logged, unknown rules are skipped and rules with the wrong number of args or invalid args fail for every value with the args hint.
+// In strict mode validating a struct with an invalid rule returns an error joining a *RuleError per invalid rule instead of a *ValidationError.
+//
+// Use Check to find invalid rules before they are used.
+func (i *Instance) SetStrict(strict bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.strict = strict
+	i.resetPlans()
+}
@@ -0,0 +1,75 @@
+package laravalidate
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestInstance(t *testing.T) {
+	instance := New(WithLanguages(language.Dutch))
+	instance.RegisterValidator("test_instance", func(ctx *ValidatorCtx) (string, bool) {
+		return "", false
+	})
+	instance.RegisterMessages(language.Dutch, map[string]MessageResolver{
+		"required":      BasicMessageResolver("Het :attribute veld is verplicht."),
+		"test_instance": BasicMessageResolver("Het :attribute veld is ongeldig."),
+	})
+
+	type Test struct {
+		Name  string `json:"name" validate:"required"`
+		Other string `json:"other" validate:"test_instance"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Test{})
+	if assert.Error(t, err) {
+		errors := err.(*ValidationError).Errors
+		assert.Len(t, errors, 2)
+		assert.Equal(t, "Het name veld is verplicht.", errors[0].Errors[0].Message)
+		assert.Equal(t, "Het other veld is ongeldig.", errors[1].Errors[0].Message)
+	}
+
+	// The default instance does not know the rule and messages of the instance
+	err = JsonValidate(nil, nil, Test{})
+	if assert.Error(t, err) {
+		errors := err.(*ValidationError).Errors
+		assert.Len(t, errors, 1)
+		assert.Equal(t, "The name field is required.", errors[0].Errors[0].Message)
+	}
+}
+
+func TestInstanceConcurrentRegister(t *testing.T) {
+	instance := New()
+
+	type Test struct {
+		Name string `json:"name" validate:"required|test_concurrent_0"`
+	}
+
+	wg := sync.WaitGroup{}
+	for idx := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			name := fmt.Sprintf("test_concurrent_%d", idx)
+			instance.RegisterValidator(name, func(ctx *ValidatorCtx) (string, bool) {
+				return "", true
+			})
+			instance.BaseRegisterMessages(map[string]MessageResolver{
+				name: BasicMessageResolver("The :attribute field is invalid."),
+			})
+		}()
+		go func() {
+			defer wg.Done()
+
+			err := instance.JsonValidate(nil, nil, Test{})
+			if assert.Error(t, err) {
+				assert.Equal(t, "The name field is required.", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -3,7 +3,9 @@ package laravalidate
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -13,17 +15,30 @@ import (
 )
 
 type Validator struct {
+	instance   *Instance
 	inputValue reflect.Value
 	ctx        context.Context
 	errors     []FieldErrors
 	languages  []string
 	mode       Mode
+	// presence contains the paths of all fields that where present in the raw input
+	// If nil the presence of fields is unknown, see JsonValidateRaw and FormValidateValues
+	presence map[string]struct{}
+	// excluded contains the paths of the fields excluded by the exclude rules
+	excluded []string
+	// abortErr is set if a validator aborted the validation using (*ValidatorCtx).Abort(..)
+	abortErr error
 	// Cache
 	customValidationMessagesCache []CustomError
 }
 
-func newValidator(ctx context.Context, languages []language.Tag, value reflect.Value, mode Mode) *Validator {
+func (i *Instance) newValidator(ctx context.Context, languages []language.Tag, value reflect.Value, mode Mode) *Validator {
+	if len(languages) == 0 {
+		languages = i.languages
+	}
+
 	return &Validator{
+		instance:   i,
 		inputValue: value,
 		ctx:        ctx,
 		errors:     []FieldErrors{},
@@ -40,45 +55,123 @@ const (
 	FormMode      // Tries to use form struct tags
 )
 
+// JsonValidate validates the input using the default instance, see (*Instance).JsonValidate
+func JsonValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return defaultInstance.JsonValidate(ctx, languages, input)
+}
+
 // JsonValidate should be used to validate a json parsed message, errors returned will have a json paths.
 // These look at the `json="xx"` struct tag for hints how to name the error keys.
 //
 // If an error is returned the type should be of *ValidationError.
+// Unless a validator aborted the validation because of an infrastructure failure (for example a database outage),
+// in that case the error of the validator is returned, see (*ValidatorCtx).Abort.
 //
 // Ctx can be set to nil, default value will be context.Background().
-// Languages can be set to nil, default value will be []language.Tag{language.English}.
-func JsonValidate(ctx context.Context, languages []language.Tag, input any) error {
-	return validate(ctx, languages, input, JsonMode)
+// Languages can be set to nil, default value will be the languages of the instance, see WithLanguages.
+func (i *Instance) JsonValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return i.validate(ctx, languages, input, JsonMode, nil)
+}
+
+// FormValidate validates the input using the default instance, see (*Instance).FormValidate
+func FormValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return defaultInstance.FormValidate(ctx, languages, input)
 }
 
 // FormValidate should be used to validate a form parsed message, errors returned will have a form paths.
 // These look at the `form="xx"` struct tag for hints how to name the error keys.
 //
 // If an error is returned the type should be of *ValidationError.
+// Unless a validator aborted the validation because of an infrastructure failure (for example a database outage),
+// in that case the error of the validator is returned, see (*ValidatorCtx).Abort.
 //
 // Ctx can be set to nil, default value will be context.Background().
-// Languages can be set to nil, default value will be []language.Tag{language.English}.
-func FormValidate(ctx context.Context, languages []language.Tag, input any) error {
-	return validate(ctx, languages, input, FormMode)
+// Languages can be set to nil, default value will be the languages of the instance, see WithLanguages.
+func (i *Instance) FormValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return i.validate(ctx, languages, input, FormMode, nil)
+}
+
+// GoValidate validates the input using the default instance, see (*Instance).GoValidate
+func GoValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return defaultInstance.GoValidate(ctx, languages, input)
 }
 
 // GoValidate should be used to validate something within a go codebase with validation errors that apply to the go codebase.
 //
 // If an error is returned the type should be of *ValidationError.
+// Unless a validator aborted the validation because of an infrastructure failure (for example a database outage),
+// in that case the error of the validator is returned, see (*ValidatorCtx).Abort.
 //
 // Ctx can be set to nil, default value will be context.Background().
-// Languages can be set to nil, default value will be []language.Tag{language.English}.
-func GoValidate(ctx context.Context, languages []language.Tag, input any) error {
-	return validate(ctx, languages, input, GoMode)
+// Languages can be set to nil, default value will be the languages of the instance, see WithLanguages.
+func (i *Instance) GoValidate(ctx context.Context, languages []language.Tag, input any) error {
+	return i.validate(ctx, languages, input, GoMode, nil)
+}
+
+// JsonValidateRaw validates the input using the default instance, see (*Instance).JsonValidateRaw
+func JsonValidateRaw(ctx context.Context, languages []language.Tag, data []byte, output any) error {
+	return defaultInstance.JsonValidateRaw(ctx, languages, data, output)
+}
+
+// JsonValidateRaw decodes the raw json message into output and validates it like JsonValidate.
+// Compared to JsonValidate the validators know which fields where actually present in the message,
+// this allows rules like required, filled, present and missing to tell a missing field apart from a zero value.
+//
+// Output must be a pointer, if decoding fails the json error is returned.
+// If the message is invalid the error will be of type *ValidationError.
+// Unless a validator aborted the validation because of an infrastructure failure (for example a database outage),
+// in that case the error of the validator is returned, see (*ValidatorCtx).Abort.
+//
+// Ctx can be set to nil, default value will be context.Background().
+// Languages can be set to nil, default value will be the languages of the instance, see WithLanguages.
+func (i *Instance) JsonValidateRaw(ctx context.Context, languages []language.Tag, data []byte, output any) error {
+	err := json.Unmarshal(data, output)
+	if err != nil {
+		return err
+	}
+
+	presence, err := jsonPresence(data)
+	if err != nil {
+		return err
+	}
+
+	return i.validate(ctx, languages, output, JsonMode, presence)
+}
+
+// FormValidateValues validates the input using the default instance, see (*Instance).FormValidateValues
+func FormValidateValues(ctx context.Context, languages []language.Tag, values url.Values, input any) error {
+	return defaultInstance.FormValidateValues(ctx, languages, values, input)
 }
 
-func validate(ctx context.Context, languages []language.Tag, input any, mode Mode) error {
+// FormValidateValues validates an already decoded form message like FormValidate.
+// The values are the raw form values (for example from (*http.Request).Form) and are used to determine which fields where actually present in the message,
+// this allows rules like required, filled, present and missing to tell a missing field apart from a zero value.
+//
+// Both `foo.bar` and `foo[bar]` style keys are supported.
+//
+// If an error is returned the type should be of *ValidationError.
+// Unless a validator aborted the validation because of an infrastructure failure (for example a database outage),
+// in that case the error of the validator is returned, see (*ValidatorCtx).Abort.
+//
+// Ctx can be set to nil, default value will be context.Background().
+// Languages can be set to nil, default value will be the languages of the instance, see WithLanguages.
+func (i *Instance) FormValidateValues(ctx context.Context, languages []language.Tag, values url.Values, input any) error {
+	return i.validate(ctx, languages, input, FormMode, formPresence(values))
+}
+
+func (i *Instance) validate(ctx context.Context, languages []language.Tag, input any, mode Mode, presence map[string]struct{}) error {
+	return i.run(ctx, languages, input, mode, presence).Error()
+}
+
+// run validates the input and returns the validator containing the results
+func (i *Instance) run(ctx context.Context, languages []language.Tag, input any, mode Mode, presence map[string]struct{}) *Validator {
 	value := reflect.ValueOf(input)
 
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	v := newValidator(ctx, languages, value, mode)
+	v := i.newValidator(ctx, languages, value, mode)
+	v.presence = presence
 
 	for value.Kind() == reflect.Ptr {
 		if !value.IsNil() {
@@ -87,22 +180,26 @@ func validate(ctx context.Context, languages []language.Tag, input any, mode Mod
 		}
 
 		v.Nil(Stack{}, value.Type().Elem())
-		return v.Error()
+		return v
 	}
 
 	switch value.Kind() {
 	case reflect.Slice, reflect.Array:
 		v.List(Stack{}, value, nil)
+	case reflect.Map:
+		v.Map(Stack{}, value, nil, nil)
 	case reflect.Struct:
 		v.Struct(Stack{}, value)
-	default:
-		return nil
 	}
 
-	return v.Error()
+	return v
 }
 
 func (v *Validator) Error() error {
+	if v.abortErr != nil {
+		return v.abortErr
+	}
+
 	if len(v.errors) == 0 {
 		return nil
 	}
@@ -138,14 +235,23 @@ func (v *Validator) List(stack Stack, value reflect.Value, validateInner []valid
 		return
 	}
 
+	validateInner = v.batch(stack, value, validateInner)
+
 	var innerStack Stack
 	var element reflect.Value
 outer:
 	for idx := 0; idx < value.Len(); idx++ {
+		if v.abortErr != nil {
+			return
+		}
+
 		element = value.Index(idx)
 		innerStack = stack.AppendIndex(idx, &value, value.Type())
 
-		v.Validate(innerStack, &element, element.Type(), validateInner)
+		if v.Validate(innerStack, &element, element.Type(), validateInner) {
+			v.exclude(innerStack)
+			continue
+		}
 
 		for element.Kind() == reflect.Ptr {
 			if element.IsNil() {
@@ -161,6 +267,65 @@ outer:
 			v.Struct(innerStack, element)
 		case reflect.Slice, reflect.Array:
 			v.List(innerStack, element, nil)
+		case reflect.Map:
+			v.Map(innerStack, element, nil, nil)
+		}
+	}
+}
+
+// Map validates the entries of a map in the order of their keys, see sortedMapKeys
+// The keys are validated using the validateKey rules and the values using the validateInner rules
+func (v *Validator) Map(stack Stack, value reflect.Value, validateInner []validationRule, validateKey []validationRule) {
+	if value.IsNil() {
+		return
+	}
+	if len(stack) > 100 {
+		return
+	}
+
+	var innerStack Stack
+	var entry reflect.Value
+outer:
+	for _, key := range sortedMapKeys(value) {
+		if v.abortErr != nil {
+			return
+		}
+
+		innerStack = stack.AppendMapKey(key, &value, value.Type())
+
+		if len(validateKey) > 0 {
+			keyValue := key
+			keyStack := innerStack.AppendMapKeyElement(&value, value.Type())
+			if v.Validate(keyStack, &keyValue, key.Type(), validateKey) {
+				v.exclude(innerStack)
+				continue
+			}
+		}
+
+		entry = value.MapIndex(key)
+		if v.Validate(innerStack, &entry, entry.Type(), validateInner) {
+			v.exclude(innerStack)
+			continue
+		}
+
+		for entry.Kind() == reflect.Ptr || entry.Kind() == reflect.Interface {
+			if entry.IsNil() {
+				if entry.Kind() == reflect.Ptr {
+					v.Nil(innerStack, entry.Type().Elem())
+				}
+				continue outer
+			}
+
+			entry = entry.Elem()
+		}
+
+		switch entry.Kind() {
+		case reflect.Struct:
+			v.Struct(innerStack, entry)
+		case reflect.Slice, reflect.Array:
+			v.List(innerStack, entry, nil)
+		case reflect.Map:
+			v.Map(innerStack, entry, nil, nil)
 		}
 	}
 }
@@ -170,20 +335,28 @@ func (v *Validator) Struct(stack Stack, value reflect.Value) {
 		return
 	}
 
-	var fieldType reflect.StructField
+	plan := v.instance.structPlanFor(value.Type())
+	if plan.strict && len(plan.ruleErrors) > 0 {
+		v.abortErr = errors.Join(plan.ruleErrors...)
+		return
+	}
+
 	var field reflect.Value
 	var innerStack Stack
 outer:
-	for idx := 0; idx < value.NumField(); idx++ {
-		fieldType = value.Type().Field(idx)
-		field = value.Field(idx)
+	for _, fieldPlan := range plan.fields {
+		if v.abortErr != nil {
+			return
+		}
 
-		innerStack = stack.AppendField(fieldType, &value, value.Type())
+		field = value.Field(fieldPlan.index)
+		innerStack = stack.appendElement(fieldPlan.element, &value)
 
-		validate := validationRules(fieldType.Tag.Get("validate"))
-		validateInner := validationRules(fieldType.Tag.Get("validateInner"))
-		if len(validate) > 0 || len(validateInner) > 0 {
-			v.Validate(innerStack, &field, fieldType.Type, validate)
+		if fieldPlan.hasRules {
+			if v.Validate(innerStack, &field, fieldPlan.fieldType, fieldPlan.validate) {
+				v.exclude(innerStack)
+				continue
+			}
 		}
 
 		for field.Kind() == reflect.Ptr {
@@ -198,7 +371,9 @@ outer:
 		case reflect.Struct:
 			v.Struct(innerStack, field)
 		case reflect.Slice, reflect.Array:
-			v.List(innerStack, field, validateInner)
+			v.List(innerStack, field, fieldPlan.validateInner)
+		case reflect.Map:
+			v.Map(innerStack, field, fieldPlan.validateInner, fieldPlan.validateKey)
 		}
 	}
 }
@@ -208,52 +383,90 @@ func (v *Validator) NilStruct(stack Stack, valueType reflect.Type) {
 		return
 	}
 
-	for idx := 0; idx < valueType.NumField(); idx++ {
-		fieldType := valueType.Field(idx)
-		innerStack := stack.AppendField(fieldType, nil, valueType)
+	plan := v.instance.structPlanFor(valueType)
+	if plan.strict && len(plan.ruleErrors) > 0 {
+		v.abortErr = errors.Join(plan.ruleErrors...)
+		return
+	}
+
+	for _, fieldPlan := range plan.fields {
+		if v.abortErr != nil {
+			return
+		}
 
-		validate := validationRules(fieldType.Tag.Get("validate"))
-		validateInner := validationRules(fieldType.Tag.Get("validateInner"))
-		if len(validate) == 0 && len(validateInner) == 0 {
+		if !fieldPlan.hasRules {
 			continue
 		}
 
-		v.Validate(innerStack, nil, fieldType.Type, validate)
+		innerStack := stack.appendElement(fieldPlan.element, nil)
 
-		for fieldType.Type.Kind() == reflect.Ptr {
-			fieldType.Type = fieldType.Type.Elem()
+		if v.Validate(innerStack, nil, fieldPlan.fieldType, fieldPlan.validate) {
+			v.exclude(innerStack)
+			continue
 		}
 
-		switch fieldType.Type.Kind() {
+		fieldType := fieldPlan.fieldType
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		switch fieldType.Kind() {
 		case reflect.Struct:
-			v.NilStruct(innerStack, fieldType.Type)
+			v.NilStruct(innerStack, fieldType)
 		}
 	}
 }
 
-func (v *Validator) Validate(stack Stack, value *reflect.Value, valueType reflect.Type, rules []validationRule) {
+// Validate runs the rules against a value and stores the errors within the validator
+// If true is returned the field was excluded by one of the rules and it's children should not be validated
+func (v *Validator) Validate(stack Stack, value *reflect.Value, valueType reflect.Type, rules []validationRule) bool {
 	if len(rules) == 0 {
-		return
+		return false
 	}
 
 	errors := []FieldValidatorError{}
 	state := &ValidatorCtxState{
 		bail:      false,
+		exclude:   false,
 		state:     map[string]any{},
 		stack:     stack,
 		validator: v,
 	}
 	for _, rule := range rules {
 		ctx := &ValidatorCtx{
-			ctx:   v.ctx,
-			Args:  rule.args,
-			state: state,
+			ctx:         v.ctx,
+			Args:        rule.args,
+			state:       state,
+			prepared:    rule.prepared,
+			preparedSet: rule.validator.Prepare != nil,
 			Needle: Needle{
 				Value: value,
 				Type:  valueType,
 			},
 		}
-		hint, ok := rule.validator.Fn(ctx)
+		var hint string
+		var ok bool
+		if rule.argsErr != nil {
+			// The args of the rule are invalid, this is reported when the rule is parsed
+			hint, ok = "args", false
+		} else if rule.batched != nil {
+			var failed bool
+			hint, failed = rule.batched[stack[len(stack)-1].Index]
+			ok = !failed
+		} else {
+			hint, ok = rule.validator.Fn(ctx)
+		}
+		if v.abortErr != nil {
+			// The result of the validator can't be trusted, the error is returned instead of the validation errors
+			return false
+		}
+		if state.exclude {
+			// Excluded fields are not part of the validated data so previous errors are dropped as well
+			return true
+		}
+		for _, elementErr := range ctx.elementErrors {
+			v.elementError(rule, ctx, elementErr)
+		}
 		if ok {
 			continue
 		}
@@ -269,23 +482,102 @@ func (v *Validator) Validate(stack Stack, value *reflect.Value, valueType reflec
 	}
 
 	if len(errors) == 0 {
-		return
+		return false
 	}
 
-	goPath, jsonPath, formPath := stack.ToPaths()
-	path := goPath
-	if v.mode == JsonMode {
-		path = jsonPath
-	} else if v.mode == FormMode {
-		path = formPath
+	v.errors = append(v.errors, FieldErrors{
+		Path:   v.stackPath(stack),
+		Errors: errors,
+	})
+	return false
+}
+
+// batch runs the batch validators of the rules against all elements of the list at once
+// The returned rules contain the results of the elements, rules without (handled) batch validator run per element as usual
+func (v *Validator) batch(stack Stack, value reflect.Value, rules []validationRule) []validationRule {
+	var batched []validationRule
+	for idx, rule := range rules {
+		if rule.validator.Batch == nil || v.abortErr != nil {
+			continue
+		}
+
+		if rule.argsErr != nil {
+			continue
+		}
+
+		ctx := &ValidatorCtx{
+			ctx:  v.ctx,
+			Args: rule.args,
+			state: &ValidatorCtxState{
+				state:     map[string]any{},
+				stack:     stack,
+				validator: v,
+			},
+			prepared:    rule.prepared,
+			preparedSet: rule.validator.Prepare != nil,
+			Needle: Needle{
+				Value: &value,
+				Type:  value.Type(),
+			},
+		}
+		if !rule.validator.Batch(ctx) {
+			continue
+		}
+
+		results := map[int]string{}
+		for _, err := range ctx.elementErrors {
+			if _, ok := results[err.index]; !ok {
+				results[err.index] = err.hint
+			}
+		}
+
+		if batched == nil {
+			// Copy the rules so the rules of the caller are not modified
+			batched = append([]validationRule{}, rules...)
+		}
+		batched[idx].batched = results
+	}
+
+	if batched == nil {
+		return rules
+	}
+	return batched
+}
+
+// elementError adds an error reported by a validator for an element of the list under validation
+func (v *Validator) elementError(rule validationRule, ctx *ValidatorCtx, err elementError) {
+	element := ctx.Value.Index(err.index)
+	stack := ctx.state.stack.AppendIndex(err.index, ctx.Value, ctx.Type)
+
+	elementCtx := &ValidatorCtx{
+		ctx:  ctx.ctx,
+		Args: ctx.Args,
+		state: &ValidatorCtxState{
+			state:     map[string]any{},
+			stack:     stack,
+			validator: v,
+		},
+		Needle: Needle{
+			Value: &element,
+			Type:  element.Type(),
+		},
 	}
 
 	v.errors = append(v.errors, FieldErrors{
-		Path:   path,
-		Errors: errors,
+		Path: v.stackPath(stack),
+		Errors: []FieldValidatorError{{
+			Rule:    rule.name,
+			Hint:    err.hint,
+			Message: v.ErrorMessage(rule.name, rule.validator.Messages, err.hint, elementCtx),
+		}},
 	})
 }
 
+// exclude marks a field as excluded from the validated data, see ValidatedData
+func (v *Validator) exclude(stack Stack) {
+	v.excluded = append(v.excluded, v.stackPath(stack))
+}
+
 func (v *Validator) ErrorMessage(ruleName string, resolvers map[string]MessageResolver, hint string, ctx *ValidatorCtx) string {
 	template := v.ErrorMessageTemplate(ruleName, resolvers, hint, ctx.state.stack)
 
@@ -299,6 +591,11 @@ outer:
 	for idx := len(variables) - 1; idx >= 0; idx-- {
 		variable := variables[idx]
 		variableName := template[variable.from:variable.to]
+		if value, ok := ctx.messageVariables[variableName[1:]]; ok {
+			replaceVariable(variable, value)
+			continue outer
+		}
+
 		switch variableName[1:] {
 		case "attribute":
 			stack := ctx.state.stack
@@ -307,46 +604,45 @@ outer:
 				continue outer
 			}
 
-			stackElement := stack[len(stack)-1]
-			if v.mode == JsonMode {
-				replaceVariable(variable, stackElement.JsonName)
-			} else if v.mode == FormMode {
-				replaceVariable(variable, stackElement.FormName)
-			} else {
-				replaceVariable(variable, stackElement.GoName)
-			}
+			replaceVariable(variable, v.attributeName(stack))
 			continue outer
 		case "other":
-			if ctx.lastObtainedField == nil || !ctx.lastObtainedField.HasValue() {
+			if len(ctx.obtainedFields) == 0 {
 				replaceVariable(variable, "")
 				continue outer
 			}
 
-			if v.mode == JsonMode {
-				jsonValue, err := json.Marshal(ctx.lastObtainedField.Value.Interface())
-				if err == nil {
-					replaceVariable(variable, string(jsonValue))
-					continue outer
-				}
+			replaceVariable(variable, v.fieldName(ctx.state.stack, ctx.obtainedFields[len(ctx.obtainedFields)-1]))
+			continue outer
+		case "others":
+			names := []string{}
+			for _, path := range ctx.obtainedFields {
+				names = append(names, v.fieldName(ctx.state.stack, path))
 			}
 
-			replaceVariable(variable, fmt.Sprintf("%+v", ctx.lastObtainedField.Value.Interface()))
+			replaceVariable(variable, strings.Join(names, ", "))
 			continue outer
 		case "value":
-			if !ctx.HasValue() {
+			// If the validator compared the value with another field we show the value of the other field
+			needle := &ctx.Needle
+			if ctx.lastObtainedField != nil {
+				needle = ctx.lastObtainedField
+			}
+
+			if !needle.HasValue() {
 				replaceVariable(variable, "")
 				continue outer
 			}
 
-			if v.mode == JsonMode {
-				jsonValue, err := json.Marshal(ctx.Value.Interface())
-				if err == nil {
-					replaceVariable(variable, string(jsonValue))
-					continue outer
-				}
+			replaceVariable(variable, v.formatValue(*needle.Value))
+			continue outer
+		case "values":
+			if len(ctx.Args) < 2 {
+				replaceVariable(variable, "")
+				continue outer
 			}
 
-			replaceVariable(variable, fmt.Sprintf("%+v", ctx.Value.Interface()))
+			replaceVariable(variable, strings.Join(ctx.Args[1:], ", "))
 			continue outer
 		case "date":
 			t, ok := ctx.DateFromArgs(0)
@@ -389,6 +685,76 @@ outer:
 	return template
 }
 
+// stackPath returns the path of a stack based on the validation mode
+func (v *Validator) stackPath(stack Stack) string {
+	goPath, jsonPath, formPath := stack.ToPaths()
+	if v.mode == JsonMode {
+		return jsonPath
+	} else if v.mode == FormMode {
+		return formPath
+	}
+	return goPath
+}
+
+// stackElementName returns the name of a stack element based on the validation mode
+func (v *Validator) stackElementName(element StackElement) string {
+	if v.mode == JsonMode {
+		return element.JsonName
+	} else if v.mode == FormMode {
+		return element.FormName
+	}
+	return element.GoName
+}
+
+// attributeName returns the name of the field at the end of the stack as used in the error messages
+// Map keys are named after the key, for example "a1 key"
+func (v *Validator) attributeName(stack Stack) string {
+	last := stack[len(stack)-1]
+	if last.Kind == StackKindMapKey && len(stack) > 1 {
+		return v.stackElementName(stack[len(stack)-2]) + " key"
+	}
+	return v.stackElementName(last)
+}
+
+// fieldName returns the attribute name of another field requested using a path relative to the stack
+// If the path cannot be resolved the path itself is returned
+func (v *Validator) fieldName(stack Stack, path string) string {
+	fieldStack, ok := v.fieldStack(stack, path)
+	if !ok || len(fieldStack) == 0 {
+		return path
+	}
+
+	// Trailing wildcards are not useful as a name, "Tags.*" should be named after "Tags"
+	for len(fieldStack) > 1 && fieldStack[len(fieldStack)-1].GoName == "*" {
+		fieldStack = fieldStack[:len(fieldStack)-1]
+	}
+
+	// Fields within a list are named using the full path, "Products.*.Sku" is named "products.*.sku" like Laravel does
+	for _, element := range fieldStack {
+		if element.GoName == "*" {
+			return v.stackPath(fieldStack)
+		}
+	}
+
+	return v.stackElementName(fieldStack[len(fieldStack)-1])
+}
+
+// formatValue formats a value so it can be used within an error message
+func (v *Validator) formatValue(value reflect.Value) string {
+	if !value.CanInterface() {
+		return ""
+	}
+
+	if v.mode == JsonMode {
+		jsonValue, err := json.Marshal(value.Interface())
+		if err == nil {
+			return string(jsonValue)
+		}
+	}
+
+	return fmt.Sprintf("%+v", value.Interface())
+}
+
 func (v *Validator) ErrorMessageTemplate(ruleName string, resolvers map[string]MessageResolver, hint string, stack Stack) string {
 	customResolver := v.CustomValidationRule(ruleName, stack)
 	if customResolver != nil {
@@ -417,6 +783,7 @@ func (v *Validator) ErrorMessageTemplate(ruleName string, resolvers map[string]M
 //
 // 1. Absolute path:
 //   - "foo.1.bar" = Get from the input (struct) the field "foo", then when it's a list like get the element at index 1 from the list, then get the field "bar" from the struct
+//   - "foo.*.bar" = Get the field "bar" of every element in the list "foo", the results are combined into a new slice
 //   - "" = Get the source input
 //
 // 2. Relative path:
@@ -428,15 +795,58 @@ func (v *Validator) ErrorMessageTemplate(ruleName string, resolvers map[string]M
 // If nil is returned the field does not exist or path is invalid
 // If a needle with only a reflect.Type is returned the path exists but the value is nil
 func (v *Validator) field(stack Stack, path string) *Needle {
-	relativity := 0
+	relativity, pathParts, ok := parseFieldPath(path)
+	if !ok {
+		return nil
+	}
+
+	if relativity == 0 || len(stack) == 0 || relativity > len(stack) {
+		// Absolute path
+		return resolveWithValue(v.inputValue, pathParts)
+	}
+
+	// Relative to the currently processed struct
+	stackElement := stack[len(stack)-relativity]
+	if stackElement.Parent == nil {
+		return resolveWithType(stackElement.ParentType, pathParts)
+	}
+
+	return resolveWithValue(*stackElement.Parent, pathParts)
+}
+
+// fieldStack returns the stack of the field that would be returned by (*Validator).field for the same path
+// This is used to obtain the go, json and form names of another field
+//
+// If false is returned the path is invalid
+func (v *Validator) fieldStack(stack Stack, path string) (Stack, bool) {
+	relativity, pathParts, ok := parseFieldPath(path)
+	if !ok {
+		return nil, false
+	}
+
+	if relativity == 0 || len(stack) == 0 || relativity > len(stack) {
+		// Absolute path
+		return resolveStackWithType(Stack{}, v.inputValue.Type(), pathParts)
+	}
+
+	// Relative to the currently processed struct, copy the base so we never write into the stack of the validator
+	base := stack[:len(stack)-relativity]
+	resp := make(Stack, len(base), len(base)+len(pathParts))
+	copy(resp, base)
+
+	return resolveStackWithType(resp, stack[len(stack)-relativity].ParentType, pathParts)
+}
+
+// parseFieldPath splits a path as accepted by (*Validator).field into the amount of relative steps and the path parts
+func parseFieldPath(path string) (relativity int, pathParts []string, ok bool) {
 	endRelative := false
-	pathParts := []string{}
+	pathParts = []string{}
 
 	for _, part := range strings.Split(path, ".") {
 		part = strings.TrimSpace(part)
 		if part == "" {
 			if endRelative {
-				return nil
+				return 0, nil, false
 			} else {
 				relativity++
 			}
@@ -447,16 +857,5 @@ func (v *Validator) field(stack Stack, path string) *Needle {
 		pathParts = append(pathParts, part)
 	}
 
-	if relativity == 0 || len(stack) == 0 || relativity > len(stack) {
-		// Absolute path
-		return resolveWithValue(v.inputValue, pathParts)
-	}
-
-	// Relative to the currently processed struct
-	stackElement := stack[len(stack)-relativity]
-	if stackElement.Parent == nil {
-		return resolveWithType(stackElement.ParentType, pathParts)
-	}
-
-	return resolveWithValue(*stackElement.Parent, pathParts)
+	return relativity, pathParts, true
 }
@@ -3,6 +3,10 @@ package laravalidate
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -186,3 +190,268 @@ func TestCustomErrorMessages(t *testing.T) {
 		Message: "Yay custom error message!",
 	}, firstValidatorErr)
 }
+
+func TestOtherFieldErrorMessages(t *testing.T) {
+	err := JsonValidate(nil, nil, struct {
+		Type        string `json:"type"`
+		CompanyName string `json:"company_name" validate:"required_if:.Type,business"`
+	}{Type: "business"})
+	assert.NotNil(t, err)
+	assert.Equal(t, `The company_name field is required when type is "business".`, err.Error())
+
+	err = GoValidate(nil, nil, struct {
+		Email string
+		Phone string
+		Post  string `validate:"required_without_all:.Email,.Phone"`
+	}{})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The Post field is required when none of Email, Phone are present.", err.Error())
+}
+
+func TestJsonValidateRaw(t *testing.T) {
+	type Body struct {
+		Age     int     `json:"age" validate:"required"`
+		Name    *string `json:"name" validate:"filled"`
+		Address struct {
+			Street string `json:"street" validate:"required"`
+		} `json:"address"`
+	}
+
+	var body Body
+	err := JsonValidateRaw(nil, nil, []byte(`{"age":0,"address":{"street":"Main street"}}`), &body)
+	assert.NoError(t, err)
+
+	err = JsonValidateRaw(nil, nil, []byte(`{"address":{"street":"Main street"}}`), &Body{})
+	assert.NotNil(t, err)
+	typedErr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Len(t, typedErr.Errors, 1)
+	assert.Equal(t, "age", typedErr.Errors[0].Path)
+
+	err = JsonValidateRaw(nil, nil, []byte(`{"age":1,"name":null,"address":{"street":"Main street"}}`), &Body{})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The name field must have a value.", err.Error())
+
+	err = JsonValidateRaw(nil, nil, []byte(`{"age":`), &Body{})
+	assert.NotNil(t, err)
+	_, ok = err.(*ValidationError)
+	assert.False(t, ok)
+}
+
+func TestFormValidateValues(t *testing.T) {
+	type Body struct {
+		Age   int `form:"age" validate:"present"`
+		Items []struct {
+			Name string `form:"name" validate:"missing"`
+		} `form:"items"`
+	}
+
+	body := Body{Items: []struct {
+		Name string `form:"name" validate:"missing"`
+	}{{}}}
+
+	assert.NoError(t, FormValidateValues(nil, nil, url.Values{"age": {"0"}, "items[0][id]": {"1"}}, body))
+
+	err := FormValidateValues(nil, nil, url.Values{"items[0][name]": {""}}, body)
+	assert.NotNil(t, err)
+	typedErr := err.(*ValidationError)
+	assert.Len(t, typedErr.Errors, 2)
+	assert.Equal(t, "age", typedErr.Errors[0].Path)
+	assert.Equal(t, "items.0.name", typedErr.Errors[1].Path)
+}
+
+func TestAbort(t *testing.T) {
+	// A separate instance so the test rules do not leak into other tests
+	instance := New()
+
+	errOutage := errors.New("database is down")
+	calls := 0
+	instance.RegisterValidator("test_abort", func(ctx *ValidatorCtx) (string, bool) {
+		calls++
+		ctx.Abort(errOutage)
+		return "test_abort", false
+	})
+
+	type Test struct {
+		Name  string `json:"name" validate:"required"`
+		Items []int  `json:"items" validateInner:"test_abort"`
+		Other string `json:"other" validate:"test_abort"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Test{Items: []int{1, 2, 3}})
+	assert.ErrorIs(t, err, errOutage)
+
+	var validationErr *ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+
+	// The validation stops at the first aborted validator
+	assert.Equal(t, 1, calls)
+}
+
+func TestBatchValidator(t *testing.T) {
+	instance := New()
+	calls := 0
+	batchCalls := 0
+	instance.RegisterValidator("test_batch", func(ctx *ValidatorCtx) (string, bool) {
+		calls++
+		value, ok := ctx.Int64()
+		return "single", !ok || value != 0
+	})
+	instance.RegisterBatchValidator("test_batch", func(ctx *ValidatorCtx) bool {
+		batchCalls++
+		if !ctx.HasValue() || ctx.Kind() != reflect.Slice || ctx.Type.Elem().Kind() != reflect.Int || ctx.Value.Len() > 4 {
+			// Falls back to the validator per element
+			return false
+		}
+
+		for idx := 0; idx < ctx.Value.Len(); idx++ {
+			if ctx.Value.Index(idx).Int()%2 == 1 {
+				ctx.ElementError(idx, "odd")
+			}
+		}
+		return true
+	})
+
+	type Test struct {
+		Items []int `json:"items" validateInner:"test_batch"`
+	}
+
+	err := instance.JsonValidate(nil, nil, Test{Items: []int{2, 3, 4, 5}})
+	assert.Equal(t, 1, batchCalls)
+	assert.Equal(t, 0, calls)
+
+	typedErr := err.(*ValidationError)
+	assert.Len(t, typedErr.Errors, 2)
+	assert.Equal(t, "items.1", typedErr.Errors[0].Path)
+	assert.Equal(t, "test_batch", typedErr.Errors[0].Errors[0].Rule)
+	assert.Equal(t, "odd", typedErr.Errors[0].Errors[0].Hint)
+	assert.Equal(t, "items.3", typedErr.Errors[1].Path)
+
+	err = instance.JsonValidate(nil, nil, Test{Items: []int{1, 1, 1, 1, 1}})
+	assert.Equal(t, 2, batchCalls)
+	assert.Equal(t, 5, calls)
+	assert.Nil(t, err)
+}
+
+func TestMap(t *testing.T) {
+	type Address struct {
+		Street string `json:"street" validate:"required"`
+	}
+
+	type Body struct {
+		Addresses map[string]Address    `json:"addresses" validateKey:"alpha_dash|max:8"`
+		Scores    map[int]int           `json:"scores" validateInner:"max:10"`
+		Groups    []map[string]*Address `json:"groups"`
+	}
+
+	err := JsonValidate(nil, nil, Body{
+		Addresses: map[string]Address{"home": {Street: "Main street"}},
+		Scores:    map[int]int{1: 5},
+		Groups:    []map[string]*Address{{"work": {Street: "Side street"}}},
+	})
+	assert.NoError(t, err)
+
+	err = JsonValidate(nil, nil, Body{
+		Addresses: map[string]Address{
+			"work":          {},
+			"home":          {},
+			"holiday home":  {Street: "Beach"},
+			"grandmas_home": {Street: "Forest"},
+		},
+		Scores: map[int]int{10: 11, 2: 12, 3: 1},
+		Groups: []map[string]*Address{{"work": {}}},
+	})
+	typedErr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+
+	paths := []string{}
+	for _, fieldErr := range typedErr.Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	assert.Equal(t, []string{
+		"addresses.grandmas_home.@key",
+		"addresses.holiday home.@key",
+		"addresses.home.street",
+		"addresses.work.street",
+		"scores.2",
+		"scores.10",
+		"groups.0.work.street",
+	}, paths)
+	assert.Equal(t, "max", typedErr.Errors[0].Errors[0].Rule)
+	assert.Equal(t, "alpha_dash", typedErr.Errors[1].Errors[0].Rule)
+	assert.Equal(t, "The street field is required.", typedErr.Errors[2].Errors[0].Message)
+}
+
+func TestMapKeyAndValueErrors(t *testing.T) {
+	type Body struct {
+		Tags map[string]string `json:"tags" validateKey:"alpha" validateInner:"alpha"`
+	}
+
+	err := JsonValidate(nil, nil, Body{Tags: map[string]string{"a1": "b2"}})
+	typedErr, ok := err.(*ValidationError)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	assert.Len(t, typedErr.Errors, 2)
+	assert.Equal(t, "tags.a1.@key", typedErr.Errors[0].Path)
+	assert.Equal(t, "The a1 key field must only contain letters.", typedErr.Errors[0].Errors[0].Message)
+	assert.Equal(t, "tags.a1", typedErr.Errors[1].Path)
+	assert.Equal(t, "The a1 field must only contain letters.", typedErr.Errors[1].Errors[0].Message)
+}
+
+func TestMapPresence(t *testing.T) {
+	type Body struct {
+		Labels map[string]struct {
+			Color string `json:"color" validate:"present"`
+		} `json:"labels"`
+	}
+
+	err := JsonValidateRaw(nil, nil, []byte(`{"labels":{"Bug":{"color":""}}}`), &Body{})
+	assert.NoError(t, err)
+
+	err = JsonValidateRaw(nil, nil, []byte(`{"labels":{"Bug":{}}}`), &Body{})
+	typedErr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, "labels.Bug.color", typedErr.Errors[0].Path)
+}
+
+func TestPrepare(t *testing.T) {
+	instance := New()
+	prepareCalls := 0
+	instance.RegisterValidator("test_prepare", func(ctx *ValidatorCtx) (string, bool) {
+		prepared, err := ctx.Prepared(func(args []string) (any, error) {
+			return nil, errors.New("not prepared in advance")
+		})
+		if err != nil {
+			return "not_prepared", false
+		}
+		max, ok := prepared.(int)
+		value, valueOk := ctx.Int64()
+		return "max", ok && valueOk && value <= int64(max)
+	})
+	instance.RegisterPrepare("test_prepare", func(args []string) (any, error) {
+		prepareCalls++
+		if len(args) != 1 {
+			return nil, errors.New("expected 1 arg")
+		}
+		return strconv.Atoi(args[0])
+	})
+
+	type Valid struct {
+		Count int `json:"count" validate:"test_prepare:5"`
+	}
+	assert.NoError(t, instance.JsonValidate(nil, nil, Valid{Count: 3}))
+	assert.Error(t, instance.JsonValidate(nil, nil, Valid{Count: 6}))
+	// The args are only prepared once for the type
+	assert.Equal(t, 1, prepareCalls)
+
+	type Invalid struct {
+		Count int `json:"count" validate:"test_prepare:five"`
+	}
+	err := instance.JsonValidate(nil, nil, Invalid{Count: 3})
+	typedErr, ok := err.(*ValidationError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "args", typedErr.Errors[0].Errors[0].Hint)
+	}
+}
@@ -1,6 +1,7 @@
 package laravalidate
 
 import (
+	"mime/multipart"
 	"reflect"
 	"time"
 
@@ -29,6 +30,7 @@ func (n *Needle) Kind() reflect.Kind {
 }
 
 // UnwrapPointer unwraps the pointer
+// Only the needle is changed, the value the needle points to is shared with the other rules of the field and stays the same
 func (n *Needle) UnwrapPointer() bool {
 	if n.Value != nil {
 		for n.Value.Kind() == reflect.Ptr {
@@ -38,8 +40,10 @@ func (n *Needle) UnwrapPointer() bool {
 				break
 			}
 
-			*n.Value = n.Value.Elem()
-			n.Type = n.Value.Type()
+			// Do not overwrite the value behind the pointer as it might be shared with other validators
+			elem := n.Value.Elem()
+			n.Value = &elem
+			n.Type = elem.Type()
 		}
 	}
 
@@ -309,3 +313,113 @@ func (n *Needle) Int64() (int64, bool) {
 
 	return 0, false
 }
+
+// EnumValidator can be implemented by enum types to report if they contain one of their allowed values
+type EnumValidator interface {
+	IsValid() bool
+}
+
+// EnumValues returns the allowed values of an enum type
+// The type must have a `Values() []T` method where T is the type itself, ok is false if there is no such method
+//
+// Values is called on the zero value of the type so it should not depend on the receiver
+func (n *Needle) EnumValues() (values []reflect.Value, ok bool) {
+	if n.Type.Kind() == reflect.Interface {
+		return nil, false
+	}
+
+	receiver := reflect.Zero(n.Type)
+	method, ok := n.Type.MethodByName("Values")
+	if !ok {
+		receiver = reflect.New(n.Type)
+		method, ok = receiver.Type().MethodByName("Values")
+		if !ok {
+			return nil, false
+		}
+	}
+
+	methodType := method.Type
+	if methodType.NumIn() != 1 || methodType.NumOut() != 1 {
+		return nil, false
+	}
+
+	out := methodType.Out(0)
+	if (out.Kind() != reflect.Slice && out.Kind() != reflect.Array) || out.Elem() != n.Type {
+		return nil, false
+	}
+
+	list := method.Func.Call([]reflect.Value{receiver})[0]
+	values = make([]reflect.Value, list.Len())
+	for idx := range values {
+		values[idx] = list.Index(idx)
+	}
+
+	return values, true
+}
+
+// EnumIsValid calls the IsValid method if the type implements EnumValidator
+// Implemented is false if the type does not implement EnumValidator or if the needle has no value
+func (n *Needle) EnumIsValid() (valid bool, implemented bool) {
+	if !n.HasValue() || n.Type.Kind() == reflect.Interface || n.Value.Type() != n.Type || !n.Value.CanInterface() {
+		return false, false
+	}
+
+	enumValidatorType := reflect.TypeOf((*EnumValidator)(nil)).Elem()
+	if n.Type.Implements(enumValidatorType) {
+		return n.Value.Interface().(EnumValidator).IsValid(), true
+	}
+
+	ptrType := reflect.PointerTo(n.Type)
+	if !ptrType.Implements(enumValidatorType) {
+		return false, false
+	}
+
+	// The method has a pointer receiver, copy the value so we don't need an addressable value
+	ptr := reflect.New(n.Type)
+	ptr.Elem().Set(*n.Value)
+	return ptr.Interface().(EnumValidator).IsValid(), true
+}
+
+var fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+
+// IsFile returns true if the type is a multipart.FileHeader
+// Note that a *multipart.FileHeader is only recognized after calling UnwrapPointer
+func (n *Needle) IsFile() bool {
+	return n.Type == fileHeaderType
+}
+
+// File returns the uploaded file if the needle contains a multipart.FileHeader
+//
+// Example:
+// ```
+//
+//	ctx.UnwrapPointer()
+//	file, status := ctx.File()
+//
+//	if !status.Oke() {
+//	  return status.Response()
+//	}
+//
+//	fmt.Println(file.Filename)
+//
+// ```
+func (n *Needle) File() (*multipart.FileHeader, ConvertStatus) {
+	if !n.IsFile() {
+		return nil, InvalidType
+	}
+
+	if !n.HasValue() {
+		return nil, ValueNil
+	}
+
+	if !n.Value.CanInterface() {
+		return nil, InvalidType
+	}
+
+	if n.Value.CanAddr() {
+		return n.Value.Addr().Interface().(*multipart.FileHeader), ConverstionOk
+	}
+
+	file := n.Value.Interface().(multipart.FileHeader)
+	return &file, ConverstionOk
+}
@@ -0,0 +1,41 @@
+package laravalidate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrapPointer(t *testing.T) {
+	number := 5
+	value := reflect.ValueOf(&number)
+	needle := Needle{Type: value.Type(), Value: &value}
+
+	needle.UnwrapPointer()
+	assert.Equal(t, reflect.Int, needle.Kind())
+	assert.Equal(t, reflect.Int, needle.Type.Kind())
+
+	// The value the needle was created with is shared with the other rules of the field and must stay untouched
+	assert.Equal(t, reflect.Ptr, value.Kind())
+}
+
+func TestUnwrapPointerBetweenRules(t *testing.T) {
+	instance := New()
+	kinds := []reflect.Kind{}
+	instance.RegisterValidator("test_unwrap", func(ctx *ValidatorCtx) (string, bool) {
+		kinds = append(kinds, ctx.Value.Kind())
+		ctx.UnwrapPointer()
+		return "", true
+	})
+
+	type Test struct {
+		Count *int `validate:"test_unwrap|test_unwrap"`
+	}
+
+	count := 5
+	assert.NoError(t, instance.GoValidate(nil, nil, Test{Count: &count}))
+
+	// Every rule starts with the pointer even if a previous rule unwrapped it
+	assert.Equal(t, []reflect.Kind{reflect.Ptr, reflect.Ptr}, kinds)
+}
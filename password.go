@@ -0,0 +1,120 @@
+package laravalidate
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"strings"
+)
+
+// CompromisedPasswordChecker checks if a password has appeared in a data leak
+// It is used by the uncompromised argument of the password rule
+type CompromisedPasswordChecker interface {
+	Compromised(ctx context.Context, password string) (bool, error)
+}
+
+// SetCompromisedPasswordChecker sets the checker of the default instance, see (*Instance).SetCompromisedPasswordChecker
+func SetCompromisedPasswordChecker(checker CompromisedPasswordChecker) {
+	defaultInstance.SetCompromisedPasswordChecker(checker)
+}
+
+// SetCompromisedPasswordChecker sets the checker used by the uncompromised argument of the password rule
+// If no checker is set the uncompromised argument is ignored
+func (i *Instance) SetCompromisedPasswordChecker(checker CompromisedPasswordChecker) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.compromisedPasswordChecker = checker
+}
+
+func (i *Instance) passwordChecker() CompromisedPasswordChecker {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.compromisedPasswordChecker
+}
+
+// CompromisedPasswordSet is an in memory CompromisedPasswordChecker
+// It contains upper case hex encoded SHA-1 hashes or hash prefixes of compromised passwords
+type CompromisedPasswordSet struct {
+	hashes map[string]struct{}
+	// lengths contains all the lengths of the hashes so prefixes can be matched using map lookups
+	lengths map[int]struct{}
+}
+
+// NewCompromisedPasswordSet creates a CompromisedPasswordSet containing the given plain text passwords
+func NewCompromisedPasswordSet(passwords ...string) *CompromisedPasswordSet {
+	set := &CompromisedPasswordSet{}
+	for _, password := range passwords {
+		set.AddHash(passwordHash(password))
+	}
+	return set
+}
+
+// LoadCompromisedPasswordFile reads a file with one hex encoded SHA-1 hash or hash prefix per line
+//
+// Lines may be suffixed with a count like the Have I Been Pwned downloads (`HASH:COUNT`),
+// the count is ignored. Empty lines and lines starting with # are skipped.
+func LoadCompromisedPasswordFile(path string) (*CompromisedPasswordSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	set := &CompromisedPasswordSet{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		set.AddHash(hash)
+	}
+
+	return set, scanner.Err()
+}
+
+// AddHash adds a hex encoded SHA-1 hash or hash prefix to the set
+func (s *CompromisedPasswordSet) AddHash(hash string) {
+	hash = strings.ToUpper(strings.TrimSpace(hash))
+	if len(hash) == 0 {
+		return
+	}
+
+	if s.hashes == nil {
+		s.hashes = map[string]struct{}{}
+		s.lengths = map[int]struct{}{}
+	}
+
+	s.hashes[hash] = struct{}{}
+	s.lengths[len(hash)] = struct{}{}
+}
+
+// Compromised implements CompromisedPasswordChecker
+func (s *CompromisedPasswordSet) Compromised(ctx context.Context, password string) (bool, error) {
+	hash := passwordHash(password)
+
+	for length := range s.lengths {
+		if length > len(hash) {
+			continue
+		}
+
+		_, ok := s.hashes[hash[:length]]
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// passwordHash returns the upper case hex encoded SHA-1 hash of a password
+func passwordHash(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,104 @@
+package laravalidate
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// structPlan contains everything the validator needs to know about a struct type
+// Plans are compiled once per type and shared between validations, they must never be modified after compiling
+type structPlan struct {
+	fields []fieldPlan
+	// ruleErrors contains the invalid rules of the fields, see Check
+	ruleErrors []error
+	// strict is set if the plan was compiled in strict mode, the ruleErrors are returned when validating instead of logged
+	strict bool
+}
+
+type fieldPlan struct {
+	// index is the index of the field within the struct
+	index int
+	// fieldType is the type of the field
+	fieldType reflect.Type
+	// element is the stack element of the field without parent
+	element StackElement
+	// hasRules is true if any of the rules below is set
+	hasRules      bool
+	validate      []validationRule
+	validateInner []validationRule
+	validateKey   []validationRule
+}
+
+// structPlanFor returns the compiled plan of a struct type
+func (i *Instance) structPlanFor(structType reflect.Type) *structPlan {
+	cached, ok := i.plans.Load(structType)
+	if ok {
+		return cached.(*structPlan)
+	}
+
+	// The read lock is held until the plan is stored so a plan using outdated validators can't be stored after resetPlans
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	compiled := i.compileStructPlan(structType)
+	compiled.strict = i.strict
+	if !compiled.strict {
+		for _, err := range compiled.ruleErrors {
+			fmt.Printf("Laravalidate: %s\n", err)
+		}
+	}
+
+	plan, _ := i.plans.LoadOrStore(structType, compiled)
+	return plan.(*structPlan)
+}
+
+// resetPlans drops all compiled plans, the write lock must be held
+// The plans contain the registered validators so they have to be recompiled when the validators change
+func (i *Instance) resetPlans() {
+	i.plans.Clear()
+}
+
+// compileStructPlan compiles the plan of a struct type, the read lock must be held
+func (i *Instance) compileStructPlan(structType reflect.Type) *structPlan {
+	plan := &structPlan{
+		fields: make([]fieldPlan, structType.NumField()),
+	}
+
+	for idx := range plan.fields {
+		field := structType.Field(idx)
+		goName, jsonName, formName := fieldNames(field)
+
+		fieldPlan := fieldPlan{
+			index:     idx,
+			fieldType: field.Type,
+			element: StackElement{
+				GoName:     goName,
+				JsonName:   jsonName,
+				FormName:   formName,
+				Index:      -1,
+				Kind:       StackKindObject,
+				ParentType: structType,
+			},
+		}
+		fieldPlan.validate = i.fieldRules(plan, structType, field, "validate")
+		fieldPlan.validateInner = i.fieldRules(plan, structType, field, "validateInner")
+		fieldPlan.validateKey = i.fieldRules(plan, structType, field, "validateKey")
+		fieldPlan.hasRules = len(fieldPlan.validate) > 0 || len(fieldPlan.validateInner) > 0 || len(fieldPlan.validateKey) > 0
+
+		plan.fields[idx] = fieldPlan
+	}
+
+	return plan
+}
+
+// fieldRules parses the rules of a struct tag of a field and adds the invalid rules to the plan
+func (i *Instance) fieldRules(plan *structPlan, structType reflect.Type, field reflect.StructField, tag string) []validationRule {
+	rules, ruleErrors := i.validationRules(field.Tag.Get(tag))
+	for _, err := range ruleErrors {
+		err.Type = structType
+		err.Field = field.Name
+		err.Tag = tag
+		plan.ruleErrors = append(plan.ruleErrors, err)
+	}
+	return rules
+}
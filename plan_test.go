@@ -0,0 +1,96 @@
+package laravalidate
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type planTestAddress struct {
+	Street  string `json:"street" form:"street" validate:"required|max:100"`
+	ZipCode string `json:"zip_code" form:"zip_code" validate:"required|alpha_dash|size:6"`
+}
+
+type planTestBody struct {
+	Name      string            `json:"name" form:"name" validate:"required|max:255"`
+	Email     string            `json:"email" form:"email" validate:"required|email"`
+	Age       int               `json:"age" form:"age" validate:"required|integer|min:18"`
+	Tags      []string          `json:"tags" form:"tags" validate:"max:10" validateInner:"alpha_dash|max:32"`
+	Address   planTestAddress   `json:"address" form:"address"`
+	Addresses []planTestAddress `json:"addresses" form:"addresses"`
+	Shipping  *planTestAddress  `json:"shipping" form:"shipping"`
+	Unrelated string            `json:"unrelated" form:"unrelated"`
+}
+
+func newPlanTestBody() planTestBody {
+	address := planTestAddress{Street: "Main street", ZipCode: "1234AB"}
+	return planTestBody{
+		Name:      "John",
+		Email:     "john@example.com",
+		Age:       30,
+		Tags:      []string{"a", "b", "c"},
+		Address:   address,
+		Addresses: []planTestAddress{address, address, address},
+		Shipping:  &address,
+	}
+}
+
+func TestStructPlan(t *testing.T) {
+	instance := New()
+	plan := instance.structPlanFor(reflect.TypeOf(planTestBody{}))
+	assert.Same(t, plan, instance.structPlanFor(reflect.TypeOf(planTestBody{})))
+
+	assert.Len(t, plan.fields, 8)
+	assert.Equal(t, "Email", plan.fields[1].element.GoName)
+	assert.Equal(t, "email", plan.fields[1].element.JsonName)
+	assert.Len(t, plan.fields[1].validate, 2)
+	assert.Len(t, plan.fields[3].validateInner, 2)
+	assert.False(t, plan.fields[7].hasRules)
+
+	// Registering a validator drops the plans as they contain the validators
+	instance.RegisterValidator("test_plan", func(ctx *ValidatorCtx) (string, bool) {
+		return "", true
+	})
+	assert.NotSame(t, plan, instance.structPlanFor(reflect.TypeOf(planTestBody{})))
+}
+
+func TestStructPlanConcurrent(t *testing.T) {
+	wg := sync.WaitGroup{}
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, JsonValidate(nil, nil, newPlanTestBody()))
+
+			invalid := newPlanTestBody()
+			invalid.Addresses[1].ZipCode = ""
+			err := FormValidate(nil, nil, invalid)
+			if assert.Error(t, err) {
+				assert.Equal(t, "addresses.1.zip_code", err.(*ValidationError).Errors[0].Path)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkJsonValidate(b *testing.B) {
+	body := newPlanTestBody()
+
+	b.ResetTimer()
+	for range b.N {
+		_ = JsonValidate(nil, nil, body)
+	}
+}
+
+func BenchmarkJsonValidateWithoutPlanCache(b *testing.B) {
+	body := newPlanTestBody()
+
+	b.ResetTimer()
+	for range b.N {
+		defaultInstance.resetPlans()
+		_ = JsonValidate(nil, nil, body)
+	}
+}
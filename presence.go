@@ -0,0 +1,113 @@
+package laravalidate
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// jsonPresence returns the paths of all fields present within a json message
+//
+// Keys are stored in lower case as encoding/json matches object keys case-insensitively with struct fields
+func jsonPresence(data []byte) (map[string]struct{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var parsed any
+	err := decoder.Decode(&parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	presence := map[string]struct{}{}
+	walkJsonPresence(presence, "", parsed)
+	return presence, nil
+}
+
+func walkJsonPresence(presence map[string]struct{}, prefix string, value any) {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		for key, entry := range typedValue {
+			path := joinPresencePath(prefix, strings.ToLower(key))
+			presence[path] = struct{}{}
+			walkJsonPresence(presence, path, entry)
+		}
+	case []any:
+		for idx, entry := range typedValue {
+			path := joinPresencePath(prefix, strconv.Itoa(idx))
+			presence[path] = struct{}{}
+			walkJsonPresence(presence, path, entry)
+		}
+	}
+}
+
+// formPresence returns the paths of all fields present within form values
+//
+// Keys like `foo[bar][0]` are converted to `foo.bar.0` and list keys like `tags[]` get an entry per value
+func formPresence(values url.Values) map[string]struct{} {
+	presence := map[string]struct{}{}
+
+	for key, entries := range values {
+		if strings.HasSuffix(key, "[]") {
+			key = formKeyToPath(key[:len(key)-2])
+			addPresencePath(presence, key)
+			for idx := range entries {
+				addPresencePath(presence, joinPresencePath(key, strconv.Itoa(idx)))
+			}
+			continue
+		}
+
+		addPresencePath(presence, formKeyToPath(key))
+	}
+
+	return presence
+}
+
+func formKeyToPath(key string) string {
+	key = strings.ReplaceAll(key, "][", ".")
+	key = strings.ReplaceAll(key, "[", ".")
+	return strings.ReplaceAll(key, "]", "")
+}
+
+// addPresencePath adds the path and all of its parents to the presence map
+func addPresencePath(presence map[string]struct{}, path string) {
+	for {
+		presence[path] = struct{}{}
+
+		idx := strings.LastIndexByte(path, '.')
+		if idx == -1 {
+			return
+		}
+		path = path[:idx]
+	}
+}
+
+func joinPresencePath(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// present returns if the field at the stack was present within the input
+// If the presence of fields is unknown a field is considered present if it is not nil
+func (v *Validator) present(stack Stack, needle *Needle) bool {
+	if v.presence == nil {
+		return needle != nil && !isNil(needle)
+	}
+
+	if len(stack) == 0 {
+		// The input itself is always present
+		return true
+	}
+
+	path := v.stackPath(stack)
+	if v.mode == JsonMode {
+		path = strings.ToLower(path)
+	}
+
+	_, ok := v.presence[path]
+	return ok
+}
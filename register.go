@@ -2,6 +2,7 @@ package laravalidate
 
 import (
 	"fmt"
+	"maps"
 	"strings"
 
 	"golang.org/x/text/language"
@@ -9,13 +10,43 @@ import (
 
 type ValidatorFn func(ctx *ValidatorCtx) (string, bool)
 
+// BatchValidatorFn validates all elements of a list at once, see RegisterBatchValidator
+// The ctx contains the list, failing elements should be reported using (*ValidatorCtx).ElementError(..)
+// If false is returned the list was not handled and the validator runs for every element separately
+type BatchValidatorFn func(ctx *ValidatorCtx) bool
+
+// PrepareFn turns the args of a rule into a compiled form, see RegisterPrepare
+// An error should be returned if the args are invalid
+type PrepareFn func(args []string) (any, error)
+
 type registeredValidatorT struct {
 	Fn ValidatorFn
+	// Batch is optional and used for the validateInner rules of lists
+	Batch BatchValidatorFn
+	// Prepare is optional and runs once when the rule is parsed
+	Prepare PrepareFn
+	// ArgCount is optional and checked when the rule is parsed
+	ArgCount *argCount
 	// The map index is the language
 	Messages map[string]MessageResolver
 }
 
-var validators = map[string]registeredValidatorT{}
+// argCount is the number of args a validator accepts, see RegisterArgCount
+type argCount struct {
+	min int
+	max int // -1 if there is no maximum
+}
+
+func (c argCount) String() string {
+	switch {
+	case c.max < 0:
+		return fmt.Sprintf("at least %d", c.min)
+	case c.min == c.max:
+		return fmt.Sprintf("%d", c.min)
+	default:
+		return fmt.Sprintf("%d to %d", c.min, c.max)
+	}
+}
 
 type MessageResolver interface {
 	Resolve(hint string) string
@@ -46,19 +77,125 @@ func (d MessageHintResolver) Resolve(hint string) string {
 
 // TODO: LanguageMessageResolver
 
-// RegisterValidator registers a new validator function
+// RegisterValidator registers a new validator function on the default instance, see (*Instance).RegisterValidator
 func RegisterValidator(name string, validator ValidatorFn) {
-	if validator != nil {
-		validators[name] = registeredValidatorT{Fn: validator, Messages: map[string]MessageResolver{}}
-	}
+	defaultInstance.RegisterValidator(name, validator)
 }
 
+// RegisterBatchValidator registers a batch function on the default instance, see (*Instance).RegisterBatchValidator
+func RegisterBatchValidator(name string, batch BatchValidatorFn) {
+	defaultInstance.RegisterBatchValidator(name, batch)
+}
+
+// BaseRegisterMessages registers english messages on the default instance, see (*Instance).BaseRegisterMessages
 func BaseRegisterMessages(resolvers map[string]MessageResolver) {
-	registerMessagesForLangs([]string{"en", "en-us", "en-gb"}, resolvers)
+	defaultInstance.BaseRegisterMessages(resolvers)
 }
 
-// RegisterMessages registers messages for a validator
+// RegisterMessages registers messages on the default instance, see (*Instance).RegisterMessages
 func RegisterMessages(lang language.Tag, resolvers map[string]MessageResolver) {
+	defaultInstance.RegisterMessages(lang, resolvers)
+}
+
+// RegisterMessagesStrict registers messages on the default instance, see (*Instance).RegisterMessagesStrict
+func RegisterMessagesStrict(lang language.Tag, resolvers map[string]MessageResolver) {
+	defaultInstance.RegisterMessagesStrict(lang, resolvers)
+}
+
+// RegisterPrepare registers a prepare function on the default instance, see (*Instance).RegisterPrepare
+func RegisterPrepare(name string, prepare PrepareFn) {
+	defaultInstance.RegisterPrepare(name, prepare)
+}
+
+// RegisterArgCount registers the number of args of a validator on the default instance, see (*Instance).RegisterArgCount
+func RegisterArgCount(name string, min, max int) {
+	defaultInstance.RegisterArgCount(name, min, max)
+}
+
+// LogValidatorsWithoutMessages logs the validators of the default instance without messages
+func LogValidatorsWithoutMessages() {
+	defaultInstance.LogValidatorsWithoutMessages()
+}
+
+// RegisterValidator registers a new validator function
+func (i *Instance) RegisterValidator(name string, validator ValidatorFn) {
+	if validator == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.validators[name] = registeredValidatorT{Fn: validator, Messages: map[string]MessageResolver{}}
+	i.resetPlans()
+}
+
+// RegisterBatchValidator registers a function that validates all elements of a list at once for an already registered validator
+// It is used for the validateInner rules of a list, this allows a validator to for example do a single database query instead of one per element.
+// The results are applied when the validator would otherwise run for an element, so the order of the rules, bail and exclude still apply.
+func (i *Instance) RegisterBatchValidator(name string, batch BatchValidatorFn) {
+	if batch == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	validator, ok := i.validators[name]
+	if !ok {
+		return
+	}
+
+	validator.Batch = batch
+	i.validators[name] = validator
+	i.resetPlans()
+}
+
+// RegisterPrepare registers a function that compiles the args of an already registered validator, for example into a *regexp.Regexp
+// It runs once when the rules of a struct type are parsed instead of for every validated value, the validator obtains the result using (*ValidatorCtx).Prepared(..)
+//
+// If prepare returns an error the rule is reported as invalid when it is parsed and the rule fails with the args hint.
+func (i *Instance) RegisterPrepare(name string, prepare PrepareFn) {
+	if prepare == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	validator, ok := i.validators[name]
+	if !ok {
+		return
+	}
+
+	validator.Prepare = prepare
+	i.validators[name] = validator
+	i.resetPlans()
+}
+
+// RegisterArgCount registers the number of args an already registered validator accepts, max should be -1 if there is no maximum
+// Rules with a different number of args are reported when they are parsed, see Check and WithStrict
+func (i *Instance) RegisterArgCount(name string, min, max int) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	validator, ok := i.validators[name]
+	if !ok {
+		return
+	}
+
+	validator.ArgCount = &argCount{min: min, max: max}
+	i.validators[name] = validator
+	i.resetPlans()
+}
+
+// BaseRegisterMessages registers english messages for validators
+func (i *Instance) BaseRegisterMessages(resolvers map[string]MessageResolver) {
+	i.registerMessagesForLangs([]string{"en", "en-us", "en-gb"}, resolvers)
+}
+
+// RegisterMessages registers messages for a validator
+func (i *Instance) RegisterMessages(lang language.Tag, resolvers map[string]MessageResolver) {
 	langStr := strings.ToLower(lang.String())
 	langParts := strings.SplitN(langStr, "-", 2)
 	langs := []string{langStr}
@@ -66,36 +203,49 @@ func RegisterMessages(lang language.Tag, resolvers map[string]MessageResolver) {
 		langs = append(langs, langParts[0])
 	}
 
-	registerMessagesForLangs(langs, resolvers)
+	i.registerMessagesForLangs(langs, resolvers)
 }
 
 // RegisterMessagesStrict registers messages for a validator
 // Compared to RegisterMessages this function does not try to match the language with the base language
 // For example if you register a message for "en-GB" it will only be used for "en-GB" and not for "en"
-func RegisterMessagesStrict(lang language.Tag, resolvers map[string]MessageResolver) {
+func (i *Instance) RegisterMessagesStrict(lang language.Tag, resolvers map[string]MessageResolver) {
 	langStr := strings.ToLower(lang.String())
-	registerMessagesForLangs([]string{langStr}, resolvers)
+	i.registerMessagesForLangs([]string{langStr}, resolvers)
 }
 
-func registerMessagesForLangs(langs []string, resolvers map[string]MessageResolver) {
+// registerMessagesForLangs adds the messages to the validators
+// The messages of a validator are replaced by a copy instead of modified, running validations might still use the old messages
+func (i *Instance) registerMessagesForLangs(langs []string, resolvers map[string]MessageResolver) {
 	if len(resolvers) == 0 {
 		return
 	}
 
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
 	for name, resolver := range resolvers {
-		validator, ok := validators[name]
+		validator, ok := i.validators[name]
 		if !ok {
 			// fmt.Printf(`Laravalidate: Trying to register error message for validation rule that does not exists "%s"`+"\n", name)
 			continue
 		}
+
+		messages := maps.Clone(validator.Messages)
 		for _, lang := range langs {
-			validator.Messages[lang] = resolver
+			messages[lang] = resolver
 		}
+		validator.Messages = messages
+		i.validators[name] = validator
 	}
+	i.resetPlans()
 }
 
-func LogValidatorsWithoutMessages() {
-	for name, validator := range validators {
+func (i *Instance) LogValidatorsWithoutMessages() {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	for name, validator := range i.validators {
 		if len(validator.Messages) == 0 {
 			fmt.Printf(`Laravalidate: No error messages registered for validation rule "%s"`+"\n", name)
 		}
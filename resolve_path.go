@@ -39,6 +39,10 @@ func resolveWithValue(value reflect.Value, path []string) *Needle {
 
 		return resolveWithValue(value.FieldByIndex(field.Index), path)
 	case reflect.Slice, reflect.Array:
+		if needle == "*" {
+			return resolveWildcard(value, path)
+		}
+
 		needleNumber, err := strconv.Atoi(needle)
 		if err != nil {
 			return nil
@@ -167,7 +171,121 @@ func resolveWithType(valueType reflect.Type, path []string) *Needle {
 			return nil
 		}
 		return resolveWithType(field.Type, path)
+	case reflect.Slice, reflect.Array:
+		if needle == "*" {
+			elementNeedle := resolveWithType(valueType.Elem(), path)
+			if elementNeedle == nil {
+				return nil
+			}
+			if containsWildcard(path) {
+				// Nested wildcards are flattened into a single list
+				return elementNeedle
+			}
+			return &Needle{Type: reflect.SliceOf(elementNeedle.Type)}
+		}
+
+		_, err := strconv.Atoi(needle)
+		if err != nil {
+			return nil
+		}
+		return resolveWithType(valueType.Elem(), path)
+	case reflect.Map:
+		return resolveWithType(valueType.Elem(), path)
 	}
 
 	return nil
 }
+
+// resolveWildcard resolves the path for every element of the list and combines the results into a new slice
+// Elements where the path resolves to nil are skipped and nested wildcards are flattened into a single list
+func resolveWildcard(list reflect.Value, path []string) *Needle {
+	typeNeedle := resolveWithType(list.Type(), append([]string{"*"}, path...))
+	if typeNeedle == nil {
+		return nil
+	}
+
+	nested := containsWildcard(path)
+	result := reflect.MakeSlice(typeNeedle.Type, 0, list.Len())
+	for idx := 0; idx < list.Len(); idx++ {
+		element := resolveWithValue(list.Index(idx), path)
+		if element == nil || !element.HasValue() {
+			continue
+		}
+
+		if nested {
+			result = reflect.AppendSlice(result, *element.Value)
+		} else {
+			result = reflect.Append(result, *element.Value)
+		}
+	}
+
+	return &Needle{
+		Type:  result.Type(),
+		Value: &result,
+	}
+}
+
+func containsWildcard(path []string) bool {
+	for _, part := range path {
+		if part == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveStackWithType walks the path using only type information and appends every step to the stack
+// If false is returned the path does not exist within the type
+func resolveStackWithType(stack Stack, valueType reflect.Type, path []string) (Stack, bool) {
+	for _, needle := range path {
+		for valueType.Kind() == reflect.Ptr {
+			valueType = valueType.Elem()
+		}
+
+		switch valueType.Kind() {
+		case reflect.Struct:
+			field, ok := valueType.FieldByName(needle)
+			if !ok {
+				return nil, false
+			}
+
+			stack = stack.AppendField(field, nil, valueType)
+			valueType = field.Type
+		case reflect.Slice, reflect.Array:
+			if needle == "*" {
+				stack = append(stack, StackElement{
+					GoName:     needle,
+					JsonName:   needle,
+					FormName:   needle,
+					Index:      -1,
+					Kind:       StackKindList,
+					ParentType: valueType,
+				})
+				valueType = valueType.Elem()
+				continue
+			}
+
+			index, err := strconv.Atoi(needle)
+			if err != nil || index < 0 {
+				return nil, false
+			}
+
+			stack = stack.AppendIndex(index, nil, valueType)
+			valueType = valueType.Elem()
+		case reflect.Map:
+			stack = append(stack, StackElement{
+				GoName:     needle,
+				JsonName:   needle,
+				FormName:   needle,
+				Index:      -1,
+				Kind:       StackKindMap,
+				ParentType: valueType,
+			})
+			valueType = valueType.Elem()
+		default:
+			return nil, false
+		}
+	}
+
+	return stack, true
+}
@@ -9,14 +9,25 @@ type validationRule struct {
 	validator registeredValidatorT
 	name      string
 	args      []string
+	// batched contains the hints of the elements that failed the batch validator by their index
+	// It is nil if the rule was not validated as batch
+	batched map[int]string
+	// prepared contains the result of the prepare function of the validator, see RegisterPrepare
+	prepared any
+	// argsErr is set if the rule has an invalid amount of args or the prepare function failed, the rule fails for every value
+	argsErr error
 }
 
-func validationRules(input string) []validationRule {
+// validationRules parses the rules of a struct tag, the read lock of the instance must be held
+// Invalid rules are returned as errors without type, field and tag, see compileStructPlan.
+// Unknown rules are left out, rules with invalid args are kept so they fail when validating.
+func (i *Instance) validationRules(input string) ([]validationRule, []*RuleError) {
 	rules := []validationRule{}
 	if len(input) == 0 {
-		return rules
+		return rules, nil
 	}
 
+	var ruleErrors []*RuleError
 	sections := strings.Split(input, "|")
 	for _, section := range sections {
 		if len(section) == 0 {
@@ -29,9 +40,9 @@ func validationRules(input string) []validationRule {
 			continue
 		}
 
-		validator, ok := validators[name]
+		validator, ok := i.validators[name]
 		if !ok {
-			fmt.Printf(`Laravalidate: Unknown validation rule "%s"`+"\n", name)
+			ruleErrors = append(ruleErrors, &RuleError{Rule: name, Err: ErrUnknownRule})
 			continue
 		}
 
@@ -40,12 +51,28 @@ func validationRules(input string) []validationRule {
 			args = strings.Split(nameAndArgs[1], ",")
 		}
 
-		rules = append(rules, validationRule{
+		rule := validationRule{
 			validator: validator,
 			name:      name,
 			args:      args,
-		})
+		}
+
+		count := validator.ArgCount
+		if count != nil && (len(args) < count.min || (count.max >= 0 && len(args) > count.max)) {
+			rule.argsErr = fmt.Errorf("%w, expected %s but got %d", ErrArgCount, count, len(args))
+		} else if validator.Prepare != nil {
+			var err error
+			rule.prepared, err = validator.Prepare(args)
+			if err != nil {
+				rule.argsErr = fmt.Errorf("%w: %w", ErrInvalidArgs, err)
+			}
+		}
+		if rule.argsErr != nil {
+			ruleErrors = append(ruleErrors, &RuleError{Rule: name, Err: rule.argsErr})
+		}
+
+		rules = append(rules, rule)
 	}
 
-	return rules
+	return rules, ruleErrors
 }
@@ -2,6 +2,10 @@ package laravalidate
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
 	"net"
 	"net/mail"
 	"net/url"
@@ -11,151 +15,259 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/oklog/ulid/v2"
 )
 
-func init() {
-	RegisterValidator("accepted", Accepted)
-
-	// Accepted If
-
-	RegisterValidator("active_url", ActiveUrl)
-	RegisterValidator("after", AfterDate)
-	RegisterValidator("after_or_equal", AfterOrEqualDate)
-	RegisterValidator("alpha", Alpha)
-	RegisterValidator("alpha_dash", AlphaDash)
-	RegisterValidator("alpha_numeric", AlphaNumeric)
+// registerBaseRules registers the built in rules and their english messages
+func registerBaseRules(i *Instance) {
+	i.RegisterValidator("accepted", Accepted)
+	i.RegisterValidator("accepted_if", AcceptedIf)
+	i.RegisterValidator("active_url", ActiveUrl)
+	i.RegisterValidator("after", AfterDate)
+	i.RegisterValidator("after_or_equal", AfterOrEqualDate)
+	i.RegisterValidator("alpha", Alpha)
+	i.RegisterValidator("alpha_dash", AlphaDash)
+	i.RegisterValidator("alpha_numeric", AlphaNumeric)
 	// Unsupported: Array
-	RegisterValidator("ascii", Ascii)
-	RegisterValidator("bail", Bail)
-	RegisterValidator("before", BeforeDate)
-	RegisterValidator("before_or_equal", BeforeOrEqualDate)
-	RegisterValidator("between", Between)
-	RegisterValidator("boolean", Boolean)
-	RegisterValidator("confirmed", Confirmed)
+	i.RegisterValidator("ascii", Ascii)
+	i.RegisterValidator("bail", Bail)
+	i.RegisterValidator("before", BeforeDate)
+	i.RegisterValidator("before_or_equal", BeforeOrEqualDate)
+	i.RegisterValidator("between", Between)
+	i.RegisterValidator("boolean", Boolean)
+	i.RegisterValidator("confirmed", Confirmed)
 
 	// Contains
 	// Current Password
 
-	RegisterValidator("date", Date)
+	i.RegisterValidator("date", Date)
 
 	// Date Equals
 
-	RegisterValidator("date_format", DateFormat)
-	// Unsupported: Decimal
-	RegisterValidator("declined", Declined)
+	i.RegisterValidator("date_format", DateFormat)
+	i.RegisterValidator("decimal", Decimal)
+	i.RegisterValidator("declined", Declined)
+	i.RegisterValidator("declined_if", DeclinedIf)
+	i.RegisterValidator("different", Different)
+	i.RegisterValidator("digits", Digits)
+	i.RegisterValidator("digits_between", DigitsBetween)
 
-	// Declined If
-	// Different
+	i.RegisterValidator("dimensions", Dimensions)
+	i.RegisterValidator("distinct", Distinct)
 
-	RegisterValidator("digits", Digits)
-	RegisterValidator("digits_between", DigitsBetween)
-
-	// Dimensions (Image Files)
-	// Distinct
 	// Doesnt Start With
 	// Doesnt End With
 
-	RegisterValidator("email", Email)
-	RegisterValidator("ends_with", EndsWith)
-
-	// Enum
-	// Exclude
-	// Exclude If
-	// Exclude Unless
-	// Exclude With
-	// Exclude Without
+	i.RegisterValidator("email", Email)
+	i.RegisterValidator("ends_with", EndsWith)
+	i.RegisterValidator("enum", Enum)
+	i.RegisterValidator("exclude", Exclude)
+	i.RegisterValidator("exclude_if", ExcludeIf)
+	i.RegisterValidator("exclude_unless", ExcludeUnless)
+	i.RegisterValidator("exclude_with", ExcludeWith)
+	i.RegisterValidator("exclude_without", ExcludeWithout)
 
 	// Provided by dbrules: Exists
-	RegisterValidator("extensions", Extensions)
-
-	// File
-
-	RegisterValidator("filled", Filled)
-	RegisterValidator("gt", Gt)
-	RegisterValidator("gte", Gte)
-	RegisterValidator("hex_color", HexColor)
-
-	// Image (File)
-
-	RegisterValidator("in", In)
-
-	// In Array
-	// Integer
-
-	RegisterValidator("ip", IP)
-	RegisterValidator("ipv4", IPV4)
-	RegisterValidator("ipv6", IPV6)
-	RegisterValidator("json", JSON)
-	RegisterValidator("lt", Lt)
-	RegisterValidator("lte", Lte)
-	RegisterValidator("lowercase", Lowercase)
+	i.RegisterValidator("extensions", Extensions)
+
+	i.RegisterValidator("file", File)
+	i.RegisterValidator("filled", Filled)
+	i.RegisterValidator("gt", Gt)
+	i.RegisterValidator("gte", Gte)
+	i.RegisterValidator("hex_color", HexColor)
+
+	i.RegisterValidator("image", Image)
+	i.RegisterValidator("in", In)
+	i.RegisterValidator("in_array", InArray)
+	i.RegisterValidator("integer", Integer)
+	i.RegisterValidator("ip", IP)
+	i.RegisterValidator("ipv4", IPV4)
+	i.RegisterValidator("ipv6", IPV6)
+	i.RegisterValidator("json", JSON)
+	i.RegisterValidator("lt", Lt)
+	i.RegisterValidator("lte", Lte)
+	i.RegisterValidator("lowercase", Lowercase)
 	// Unsupported: List
-	RegisterValidator("mac_address", MacAddress)
-	RegisterValidator("max", Max)
-	RegisterValidator("max_digits", MaxDigits)
-	RegisterValidator("mimetypes", Mimetypes)
-	RegisterValidator("mimes", Mimes)
-	RegisterValidator("min", Min)
-	RegisterValidator("min_digits", MinDigits)
-
-	// Missing
-	// Missing If
-	// Missing Unless
-	// Missing With
-	// Missing With All
-	// Multiple Of
-
-	RegisterValidator("not_nil", NotNil)
-	RegisterValidator("not_in", NotIn)
-	RegisterValidator("not_regex", NotRegex)
+	i.RegisterValidator("mac_address", MacAddress)
+	i.RegisterValidator("max", Max)
+	i.RegisterValidator("max_digits", MaxDigits)
+	i.RegisterValidator("mimetypes", Mimetypes)
+	i.RegisterValidator("mimes", Mimes)
+	i.RegisterValidator("min", Min)
+	i.RegisterValidator("min_digits", MinDigits)
+
+	i.RegisterValidator("missing", Missing)
+	i.RegisterValidator("missing_if", MissingIf)
+	i.RegisterValidator("missing_unless", MissingUnless)
+	i.RegisterValidator("missing_with", MissingWith)
+	i.RegisterValidator("missing_with_all", MissingWithAll)
+
+	i.RegisterValidator("multiple_of", MultipleOf)
+	i.RegisterValidator("not_nil", NotNil)
+	i.RegisterValidator("not_in", NotIn)
+	i.RegisterValidator("not_regex", NotRegex)
 	// Unsupported: Nullable
-	RegisterValidator("numeric", Numeric)
-
-	// Present
-	// Present If
-	// Present Unless
-	// Present With
-	// Present With All
-	// Prohibited
-	// Prohibited If
-	// Prohibited Unless
-	// Prohibits
-
-	RegisterValidator("regex", Regex)
-	RegisterValidator("required", Required)
-
-	// Required If
-	// Required If Accepted
-	// Required If Declined
-	// Required Unless
-	// Required With
-	// Required With All
-	// Required Without
-	// Required Without All
-	// Required Array Keys
-	// Same
-
-	RegisterValidator("size", Size)
+	i.RegisterValidator("numeric", Numeric)
+	i.RegisterValidator("password", Password)
+
+	i.RegisterValidator("present", Present)
+	i.RegisterValidator("present_if", PresentIf)
+	i.RegisterValidator("present_unless", PresentUnless)
+	i.RegisterValidator("present_with", PresentWith)
+	i.RegisterValidator("present_with_all", PresentWithAll)
+	i.RegisterValidator("prohibited", Prohibited)
+	i.RegisterValidator("prohibited_if", ProhibitedIf)
+	i.RegisterValidator("prohibited_unless", ProhibitedUnless)
+	i.RegisterValidator("prohibits", Prohibits)
+	i.RegisterValidator("regex", Regex)
+	i.RegisterValidator("required", Required)
+	i.RegisterValidator("required_if", RequiredIf)
+	i.RegisterValidator("required_if_accepted", RequiredIfAccepted)
+	i.RegisterValidator("required_if_declined", RequiredIfDeclined)
+	i.RegisterValidator("required_unless", RequiredUnless)
+	i.RegisterValidator("required_with", RequiredWith)
+	i.RegisterValidator("required_with_all", RequiredWithAll)
+	i.RegisterValidator("required_without", RequiredWithout)
+	i.RegisterValidator("required_without_all", RequiredWithoutAll)
+	i.RegisterValidator("required_array_keys", RequiredArrayKeys)
+	i.RegisterValidator("same", Same)
+	i.RegisterValidator("size", Size)
 
 	// Sometimes
 
-	RegisterValidator("starts_with", StartsWith)
+	i.RegisterValidator("starts_with", StartsWith)
 	// Unsupported: String
 
 	// Timezone
-	// Unique (Database)
 
-	RegisterValidator("uppercase", Uppercase)
-	RegisterValidator("url", URL)
-	RegisterValidator("ulid", Ulid)
-	RegisterValidator("uuid", Uuid)
-
-	BaseRegisterMessages(map[string]MessageResolver{
-		"accepted": BasicMessageResolver("The :attribute field must be accepted."),
-		// "accepted_if": BasicMessageResolver("The :attribute field must be accepted when :other is :value."),
+	// Provided by dbrules: Unique
+	i.RegisterValidator("uppercase", Uppercase)
+	i.RegisterValidator("url", URL)
+	i.RegisterValidator("ulid", Ulid)
+	i.RegisterValidator("uuid", Uuid)
+
+	// Compile the args of rules once instead of for every value
+	i.RegisterPrepare("after", prepareDate)
+	i.RegisterPrepare("after_or_equal", prepareDate)
+	i.RegisterPrepare("before", prepareDate)
+	i.RegisterPrepare("before_or_equal", prepareDate)
+	i.RegisterPrepare("in", prepareSet)
+	i.RegisterPrepare("not_in", prepareSet)
+	i.RegisterPrepare("regex", prepareRegex)
+	i.RegisterPrepare("not_regex", prepareRegex)
+	i.RegisterPrepare("between", prepareNumbers)
+	i.RegisterPrepare("max", prepareNumbers)
+	i.RegisterPrepare("min", prepareNumbers)
+	i.RegisterPrepare("size", prepareNumbers)
+	i.RegisterPrepare("decimal", prepareIntegers)
+	i.RegisterPrepare("digits", prepareIntegers)
+	i.RegisterPrepare("digits_between", prepareIntegers)
+	i.RegisterPrepare("max_digits", prepareIntegers)
+	i.RegisterPrepare("min_digits", prepareIntegers)
+	i.RegisterPrepare("multiple_of", prepareMultipleOf)
+	i.RegisterPrepare("password", preparePassword)
+
+	// Rules with a different number of args are reported when parsing the rules, see Check
+	for name, count := range map[string]argCount{
+		"accepted":             {0, 0},
+		"accepted_if":          {2, -1},
+		"active_url":           {0, 0},
+		"after":                {1, 1},
+		"after_or_equal":       {1, 1},
+		"alpha":                {0, 0},
+		"alpha_dash":           {0, 0},
+		"alpha_numeric":        {0, 0},
+		"ascii":                {0, 0},
+		"bail":                 {0, 0},
+		"before":               {1, 1},
+		"before_or_equal":      {1, 1},
+		"between":              {2, 2},
+		"boolean":              {0, 0},
+		"confirmed":            {0, 0},
+		"date":                 {0, 0},
+		"date_format":          {1, -1},
+		"decimal":              {1, 2},
+		"declined":             {0, 0},
+		"declined_if":          {2, -1},
+		"different":            {1, 1},
+		"digits":               {1, 1},
+		"digits_between":       {2, 2},
+		"dimensions":           {1, -1},
+		"distinct":             {0, 2},
+		"ends_with":            {1, -1},
+		"enum":                 {0, 0},
+		"exclude":              {0, 0},
+		"exclude_if":           {2, -1},
+		"exclude_unless":       {2, -1},
+		"exclude_with":         {1, -1},
+		"exclude_without":      {1, -1},
+		"extensions":           {1, -1},
+		"file":                 {0, 0},
+		"filled":               {0, 0},
+		"gt":                   {1, 1},
+		"gte":                  {1, 1},
+		"hex_color":            {0, 0},
+		"image":                {0, 0},
+		"in":                   {1, -1},
+		"in_array":             {1, 1},
+		"integer":              {0, 1},
+		"ip":                   {0, 0},
+		"json":                 {0, 0},
+		"lt":                   {1, 1},
+		"lte":                  {1, 1},
+		"lowercase":            {0, 0},
+		"mac_address":          {0, 0},
+		"max":                  {1, 1},
+		"max_digits":           {1, 1},
+		"mimetypes":            {1, -1},
+		"mimes":                {1, -1},
+		"min":                  {1, 1},
+		"min_digits":           {1, 1},
+		"missing":              {0, 0},
+		"missing_if":           {2, -1},
+		"missing_unless":       {2, -1},
+		"missing_with":         {1, -1},
+		"missing_with_all":     {1, -1},
+		"multiple_of":          {1, 1},
+		"not_nil":              {0, 0},
+		"not_in":               {1, -1},
+		"not_regex":            {1, -1},
+		"numeric":              {0, 0},
+		"present":              {0, 0},
+		"present_if":           {2, -1},
+		"present_unless":       {2, -1},
+		"present_with":         {1, -1},
+		"present_with_all":     {1, -1},
+		"prohibited":           {0, 0},
+		"prohibited_if":        {2, -1},
+		"prohibited_unless":    {2, -1},
+		"prohibits":            {1, -1},
+		"regex":                {1, -1},
+		"required":             {0, 0},
+		"required_if":          {2, -1},
+		"required_if_accepted": {1, 1},
+		"required_if_declined": {1, 1},
+		"required_unless":      {2, -1},
+		"required_with":        {1, -1},
+		"required_with_all":    {1, -1},
+		"required_without":     {1, -1},
+		"required_without_all": {1, -1},
+		"required_array_keys":  {1, -1},
+		"same":                 {1, 1},
+		"size":                 {1, 1},
+		"starts_with":          {1, -1},
+		"uppercase":            {0, 0},
+		"ulid":                 {0, 0},
+	} {
+		i.RegisterArgCount(name, count.min, count.max)
+	}
+
+	i.BaseRegisterMessages(map[string]MessageResolver{
+		"accepted":       BasicMessageResolver("The :attribute field must be accepted."),
+		"accepted_if":    BasicMessageResolver("The :attribute field must be accepted when :other is :value."),
 		"active_url":     BasicMessageResolver("The :attribute field must be a valid URL."),
 		"after":          BasicMessageResolver("The :attribute field must be a date after :date."),
 		"after_or_equal": BasicMessageResolver("The :attribute field must be a date after or equal to :date."),
@@ -184,23 +296,39 @@ func init() {
 		"date": BasicMessageResolver("The :attribute field must be a valid date."),
 		// "date_equals": BasicMessageResolver("The :attribute field must be a date equal to :date."),
 		"date_format": BasicMessageResolver("The :attribute field must match the format :arg."),
-		// "decimal": BasicMessageResolver("The :attribute field must have :arg decimal places."),
-		"declined": BasicMessageResolver("The :attribute field must be declined."),
-		// "declined_if": BasicMessageResolver("The :attribute field must be declined when :other is :value."),
-		// "different":   BasicMessageResolver("The :attribute field and :other must be different."),
+		"decimal": MessageHintResolver{
+			Fallback: "The :attribute field must have :arg decimal places.",
+			Hints: map[string]string{
+				"between": "The :attribute field must have between :arg0 and :arg1 decimal places.",
+			},
+		},
+		"declined":       BasicMessageResolver("The :attribute field must be declined."),
+		"declined_if":    BasicMessageResolver("The :attribute field must be declined when :other is :value."),
+		"different":      BasicMessageResolver("The :attribute field and :other must be different."),
 		"digits":         BasicMessageResolver("The :attribute field must be :digits digits."),
 		"digits_between": BasicMessageResolver("The :attribute field must be between :arg0 and :arg1 digits."),
-		// "dimensions":        BasicMessageResolver("The :attribute field has invalid image dimensions."),
-		// "distinct":          BasicMessageResolver("The :attribute field has a duplicate value."),
+		"dimensions":     BasicMessageResolver("The :attribute field has invalid image dimensions."),
+		"distinct":       BasicMessageResolver("The :attribute field has a duplicate value."),
 		// "doesnt_end_with":   BasicMessageResolver("The :attribute field must not end with one of the following: :args."),
 		// "doesnt_start_with": BasicMessageResolver("The :attribute field must not start with one of the following: :args."),
 		"email":     BasicMessageResolver("The :attribute field must be a valid email address."),
 		"ends_with": BasicMessageResolver("The :attribute field must end with one of the following: :args."),
-		// "enum":    BasicMessageResolver("The selected :attribute is invalid."),
-		"exists":     BasicMessageResolver("The selected :attribute is invalid."),
-		"extensions": BasicMessageResolver("The :attribute field must have one of the following extensions: :args."),
-		// "file":       BasicMessageResolver("The :attribute field must be a file."),
-		"filled": BasicMessageResolver("The :attribute field must have a value."),
+		"enum": MessageHintResolver{
+			Fallback: "The selected :attribute is invalid.",
+			Hints: map[string]string{
+				"values": "The selected :attribute is invalid, permitted values are :values.",
+			},
+		},
+		// The exclude rules never fail, like bail their message only exists so LogValidatorsWithoutMessages does not report them
+		"exclude":         BasicMessageResolver("The :attribute field must pass."),
+		"exclude_if":      BasicMessageResolver("The :attribute field must pass."),
+		"exclude_unless":  BasicMessageResolver("The :attribute field must pass."),
+		"exclude_with":    BasicMessageResolver("The :attribute field must pass."),
+		"exclude_without": BasicMessageResolver("The :attribute field must pass."),
+		"exists":          BasicMessageResolver("The selected :attribute is invalid."),
+		"extensions":      BasicMessageResolver("The :attribute field must have one of the following extensions: :args."),
+		"file":            BasicMessageResolver("The :attribute field must be a file."),
+		"filled":          BasicMessageResolver("The :attribute field must have a value."),
 		"gt": MessageHintResolver{Hints: map[string]string{
 			"array":   "The :attribute field must have more than :value items.",
 			"file":    "The :attribute field must be greater than :value kilobytes.",
@@ -214,14 +342,14 @@ func init() {
 			"string":  "The :attribute field must be greater than or equal to :value characters.",
 		}},
 		"hex_color": BasicMessageResolver("The :attribute field must be a valid hexadecimal color."),
-		// "image":     BasicMessageResolver("The :attribute field must be an image."),
-		"in": BasicMessageResolver("The selected :attribute is invalid."),
-		// "in_array":  BasicMessageResolver("The :attribute field must exist in :other."),
-		// "integer":   BasicMessageResolver("The :attribute field must be an integer."),
-		"ip":   BasicMessageResolver("The :attribute field must be a valid IP address."),
-		"ipv4": BasicMessageResolver("The :attribute field must be a valid IPv4 address."),
-		"ipv6": BasicMessageResolver("The :attribute field must be a valid IPv6 address."),
-		"json": BasicMessageResolver("The :attribute field must be a valid JSON string."),
+		"image":     BasicMessageResolver("The :attribute field must be an image."),
+		"in":        BasicMessageResolver("The selected :attribute is invalid."),
+		"in_array":  BasicMessageResolver("The :attribute field must exist in :other."),
+		"integer":   BasicMessageResolver("The :attribute field must be an integer."),
+		"ip":        BasicMessageResolver("The :attribute field must be a valid IP address."),
+		"ipv4":      BasicMessageResolver("The :attribute field must be a valid IPv4 address."),
+		"ipv6":      BasicMessageResolver("The :attribute field must be a valid IPv6 address."),
+		"json":      BasicMessageResolver("The :attribute field must be a valid JSON string."),
 		// "list":      BasicMessageResolver("The :attribute field must be a list."),
 		"lowercase": BasicMessageResolver("The :attribute field must be lowercase."),
 		"lt": MessageHintResolver{Hints: map[string]string{
@@ -247,8 +375,20 @@ func init() {
 			},
 		},
 		"max_digits": BasicMessageResolver("The :attribute field must not have more than :max digits."),
-		"mimes":      BasicMessageResolver("The :attribute field must be a file of type: :args."),
-		"mimetypes":  BasicMessageResolver("The :attribute field must be a file of type: :args."),
+		"mimes": MessageHintResolver{
+			Fallback: "The :attribute field must be a file of type: :args.",
+			Hints: map[string]string{
+				"detected": "The :attribute field must be a file of type: :args, the detected type is :detected.",
+				"declared": "The :attribute field must be a file of type: :args, the detected type is :detected while :declared was declared.",
+			},
+		},
+		"mimetypes": MessageHintResolver{
+			Fallback: "The :attribute field must be a file of type: :args.",
+			Hints: map[string]string{
+				"detected": "The :attribute field must be a file of type: :args, the detected type is :detected.",
+				"declared": "The :attribute field must be a file of type: :args, the detected type is :detected while :declared was declared.",
+			},
+		},
 		"min": MessageHintResolver{
 			Fallback: "The :attribute field must be at least :arg.",
 			Hints: map[string]string{
@@ -258,45 +398,50 @@ func init() {
 				"string":  "The :attribute field must be at least :arg characters.",
 			},
 		},
-		"min_digits": BasicMessageResolver("The :attribute field must have at least :arg digits."),
-		// "missing":          BasicMessageResolver("The :attribute field must be missing."),
-		// "missing_if":       BasicMessageResolver("The :attribute field must be missing when :other is :value."),
-		// "missing_unless":   BasicMessageResolver("The :attribute field must be missing unless :other is :value."),
-		// "missing_with":     BasicMessageResolver("The :attribute field must be missing when :args is present."),
-		// "missing_with_all": BasicMessageResolver("The :attribute field must be missing when :args are present."),
-		// "multiple_of":      BasicMessageResolver("The :attribute field must be a multiple of :value."),
-		"not_nil":   BasicMessageResolver("The :attribute field must not be nil."),
-		"not_in":    BasicMessageResolver("The selected :attribute is invalid."),
-		"not_regex": BasicMessageResolver("The :attribute field format is invalid."),
-		"numeric":   BasicMessageResolver("The :attribute field must be a number."),
-		// "password": MessageHintResolver{Hints: map[string]string{
-		// 	"letters":       "The :attribute field must contain at least one letter.",
-		// 	"mixed":         "The :attribute field must contain at least one uppercase and one lowercase letter.",
-		// 	"numbers":       "The :attribute field must contain at least one number.",
-		// 	"symbols":       "The :attribute field must contain at least one symbol.",
-		// 	"uncompromised": "The given :attribute has appeared in a data leak. Please choose a different :attribute.",
-		// }},
-		// "present":           BasicMessageResolver("The :attribute field must be present."),
-		// "present_if":        BasicMessageResolver("The :attribute field must be present when :other is :value."),
-		// "present_unless":    BasicMessageResolver("The :attribute field must be present unless :other is :value."),
-		// "present_with":      BasicMessageResolver("The :attribute field must be present when :args is present."),
-		// "present_with_all":  BasicMessageResolver("The :attribute field must be present when :args are present."),
-		// "prohibited":        BasicMessageResolver("The :attribute field is prohibited."),
-		// "prohibited_if":     BasicMessageResolver("The :attribute field is prohibited when :other is :value."),
-		// "prohibited_unless": BasicMessageResolver("The :attribute field is prohibited unless :other is in :args."),
-		// "prohibits":         BasicMessageResolver("The :attribute field prohibits :other from being present."),
-		"regex":    BasicMessageResolver("The :attribute field format is invalid."),
-		"required": BasicMessageResolver("The :attribute field is required."),
-		// "required_array_keys":  BasicMessageResolver("The :attribute field must contain entries for: :args."),
-		// "required_if":          BasicMessageResolver("The :attribute field is required when :other is :value."),
-		// "required_if_accepted": BasicMessageResolver("The :attribute field is required when :other is accepted."),
-		// "required_if_declined": BasicMessageResolver("The :attribute field is required when :other is declined."),
-		// "required_unless":      BasicMessageResolver("The :attribute field is required unless :other is in :args."),
-		// "required_with":        BasicMessageResolver("The :attribute field is required when :args is present."),
-		// "required_with_all":    BasicMessageResolver("The :attribute field is required when :args are present."),
-		// "required_without":     BasicMessageResolver("The :attribute field is required when :args is not present."),
-		// "required_without_all": BasicMessageResolver("The :attribute field is required when none of :args are present."),
-		// "same": BasicMessageResolver("The :attribute field must match :other."),
+		"min_digits":       BasicMessageResolver("The :attribute field must have at least :arg digits."),
+		"missing":          BasicMessageResolver("The :attribute field must be missing."),
+		"missing_if":       BasicMessageResolver("The :attribute field must be missing when :other is :value."),
+		"missing_unless":   BasicMessageResolver("The :attribute field must be missing unless :other is in :values."),
+		"missing_with":     BasicMessageResolver("The :attribute field must be missing when :others is present."),
+		"missing_with_all": BasicMessageResolver("The :attribute field must be missing when :others are present."),
+		"multiple_of":      BasicMessageResolver("The :attribute field must be a multiple of :arg."),
+		"not_nil":          BasicMessageResolver("The :attribute field must not be nil."),
+		"not_in":           BasicMessageResolver("The selected :attribute is invalid."),
+		"not_regex":        BasicMessageResolver("The :attribute field format is invalid."),
+		"numeric":          BasicMessageResolver("The :attribute field must be a number."),
+		"password": MessageHintResolver{
+			Fallback: "The :attribute field must be a string.",
+			Hints: map[string]string{
+				"min":           "The :attribute field must be at least :min characters.",
+				"max":           "The :attribute field must not be greater than :max characters.",
+				"letters":       "The :attribute field must contain at least one letter.",
+				"mixed":         "The :attribute field must contain at least one uppercase and one lowercase letter.",
+				"numbers":       "The :attribute field must contain at least one number.",
+				"symbols":       "The :attribute field must contain at least one symbol.",
+				"uncompromised": "The given :attribute has appeared in a data leak. Please choose a different :attribute.",
+			},
+		},
+		"present":              BasicMessageResolver("The :attribute field must be present."),
+		"present_if":           BasicMessageResolver("The :attribute field must be present when :other is :value."),
+		"present_unless":       BasicMessageResolver("The :attribute field must be present unless :other is in :values."),
+		"present_with":         BasicMessageResolver("The :attribute field must be present when :others is present."),
+		"present_with_all":     BasicMessageResolver("The :attribute field must be present when :others are present."),
+		"prohibited":           BasicMessageResolver("The :attribute field is prohibited."),
+		"prohibited_if":        BasicMessageResolver("The :attribute field is prohibited when :other is :value."),
+		"prohibited_unless":    BasicMessageResolver("The :attribute field is prohibited unless :other is in :values."),
+		"prohibits":            BasicMessageResolver("The :attribute field prohibits :other from being present."),
+		"regex":                BasicMessageResolver("The :attribute field format is invalid."),
+		"required":             BasicMessageResolver("The :attribute field is required."),
+		"required_array_keys":  BasicMessageResolver("The :attribute field must contain entries for: :args."),
+		"required_if":          BasicMessageResolver("The :attribute field is required when :other is :value."),
+		"required_if_accepted": BasicMessageResolver("The :attribute field is required when :other is accepted."),
+		"required_if_declined": BasicMessageResolver("The :attribute field is required when :other is declined."),
+		"required_unless":      BasicMessageResolver("The :attribute field is required unless :other is in :values."),
+		"required_with":        BasicMessageResolver("The :attribute field is required when :others is present."),
+		"required_with_all":    BasicMessageResolver("The :attribute field is required when :others are present."),
+		"required_without":     BasicMessageResolver("The :attribute field is required when :others is not present."),
+		"required_without_all": BasicMessageResolver("The :attribute field is required when none of :others are present."),
+		"same":                 BasicMessageResolver("The :attribute field must match :other."),
 		"size": MessageHintResolver{
 			Fallback: "The :attribute field must be of size :arg.",
 			Hints: map[string]string{
@@ -308,7 +453,7 @@ func init() {
 		"starts_with": BasicMessageResolver("The :attribute field must start with one of the following: :args."),
 		// "string":   BasicMessageResolver("The :attribute field must be a string."),
 		// "timezone": BasicMessageResolver("The :attribute field must be a valid timezone."),
-		// "unique":   BasicMessageResolver("The :attribute has already been taken."),
+		"unique": BasicMessageResolver("The :attribute has already been taken."),
 		// "uploaded": BasicMessageResolver("The :attribute failed to upload."),
 		"uppercase": BasicMessageResolver("The :attribute field must be uppercase."),
 		"url":       BasicMessageResolver("The :attribute field must be a valid URL."),
@@ -316,48 +461,353 @@ func init() {
 		"uuid":      BasicMessageResolver("The :attribute field must be a valid UUID."),
 	})
 
-	LogValidatorsWithoutMessages()
+	i.LogValidatorsWithoutMessages()
 }
 
 func Required(ctx *ValidatorCtx) (string, bool) {
+	if !ctx.Present() || empty(&ctx.Needle) {
+		return "required", false
+	}
+
+	return "", true
+}
+
+func NotNil(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
 
 	if !ctx.HasValue() {
-		return "required", false
+		return "nil", false
 	}
 
 	switch ctx.Kind() {
-	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Interface:
-		if ctx.Value.IsNil() {
-			return "required", false
-		}
-	case reflect.Map, reflect.Slice:
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Interface, reflect.Map, reflect.Slice:
 		if ctx.Value.IsNil() {
-			return "required", false
+			return "nil", false
 		}
-		if ctx.Value.Len() == 0 {
-			return "required", false
+	}
+
+	return "", true
+}
+
+func RequiredIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredIfAccepted(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	other := ctx.Field(ctx.Args[0])
+	if other == nil {
+		return "", true
+	}
+
+	if _, ok := accepted(other); !ok {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredIfDeclined(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	other := ctx.Field(ctx.Args[0])
+	if other == nil {
+		return "", true
+	}
+
+	if _, ok := declined(other); !ok {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredUnless(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+// filledFields counts the fields of the args that are filled, see the required validator for what is considered filled
+// All fields are always requested so they can be used within the error message
+func filledFields(ctx *ValidatorCtx) int {
+	filled := 0
+	for _, path := range ctx.Args {
+		other := ctx.Field(path)
+		if other != nil && !empty(other) {
+			filled++
 		}
-	case reflect.String:
-		if ctx.Value.String() == "" {
-			return "required", false
+	}
+
+	return filled
+}
+
+func RequiredWith(ctx *ValidatorCtx) (string, bool) {
+	if filledFields(ctx) == 0 {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredWithAll(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 || filledFields(ctx) != len(ctx.Args) {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredWithout(ctx *ValidatorCtx) (string, bool) {
+	if filledFields(ctx) == len(ctx.Args) {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func RequiredWithoutAll(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 || filledFields(ctx) != 0 {
+		return "", true
+	}
+
+	return Required(ctx)
+}
+
+func Exclude(ctx *ValidatorCtx) (string, bool) {
+	ctx.Exclude()
+	return "", true
+}
+
+func ExcludeIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		ctx.Exclude()
+	}
+
+	return "", true
+}
+
+func ExcludeUnless(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		ctx.Exclude()
+	}
+
+	return "", true
+}
+
+func ExcludeWith(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) > 0 && filledFields(ctx) > 0 {
+		ctx.Exclude()
+	}
+
+	return "", true
+}
+
+func ExcludeWithout(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) > 0 && filledFields(ctx) != len(ctx.Args) {
+		ctx.Exclude()
+	}
+
+	return "", true
+}
+
+func Present(ctx *ValidatorCtx) (string, bool) {
+	if !ctx.Present() {
+		return "present", false
+	}
+
+	return "", true
+}
+
+func PresentIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Present(ctx)
+}
+
+func PresentUnless(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Present(ctx)
+}
+
+// presentFields counts the fields of the args that are present, see (*ValidatorCtx).Present() for what is considered present
+// All fields are always requested so they can be used within the error message
+func presentFields(ctx *ValidatorCtx) int {
+	present := 0
+	for _, path := range ctx.Args {
+		if ctx.FieldPresent(path) {
+			present++
 		}
 	}
 
+	return present
+}
+
+func PresentWith(ctx *ValidatorCtx) (string, bool) {
+	if presentFields(ctx) == 0 {
+		return "", true
+	}
+
+	return Present(ctx)
+}
+
+func PresentWithAll(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 || presentFields(ctx) != len(ctx.Args) {
+		return "", true
+	}
+
+	return Present(ctx)
+}
+
+func Missing(ctx *ValidatorCtx) (string, bool) {
+	if ctx.Present() {
+		return "missing", false
+	}
+
 	return "", true
 }
 
-func NotNil(ctx *ValidatorCtx) (string, bool) {
-	ctx.UnwrapPointer()
+func MissingIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
 
-	if !ctx.HasValue() {
-		return "nil", false
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
 	}
 
-	switch ctx.Kind() {
-	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Interface, reflect.Map, reflect.Slice:
-		if ctx.Value.IsNil() {
-			return "nil", false
+	return Missing(ctx)
+}
+
+func MissingUnless(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Missing(ctx)
+}
+
+func MissingWith(ctx *ValidatorCtx) (string, bool) {
+	if presentFields(ctx) == 0 {
+		return "", true
+	}
+
+	return Missing(ctx)
+}
+
+func MissingWithAll(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 || presentFields(ctx) != len(ctx.Args) {
+		return "", true
+	}
+
+	return Missing(ctx)
+}
+
+func Prohibited(ctx *ValidatorCtx) (string, bool) {
+	if prohibitedFilled(ctx, &ctx.Needle, ctx.Present()) {
+		return "prohibited", false
+	}
+
+	return "", true
+}
+
+// prohibitedFilled returns true if a field is filled in the sense of the prohibited rules
+// If the presence of fields is known a field is filled if it is present and not empty.
+// Otherwise zero values count as absent as well, so value typed fields like an int or bool can be prohibited.
+func prohibitedFilled(ctx *ValidatorCtx, n *Needle, present bool) bool {
+	if n == nil || !present {
+		return false
+	}
+
+	pointer := n.Type != nil && n.Type.Kind() == reflect.Ptr
+	if empty(n) {
+		return false
+	}
+
+	if ctx.state.validator.presence != nil || pointer {
+		return true
+	}
+	return !n.Value.IsZero()
+}
+
+func ProhibitedIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Prohibited(ctx)
+}
+
+func ProhibitedUnless(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Prohibited(ctx)
+}
+
+func Prohibits(ctx *ValidatorCtx) (string, bool) {
+	if !prohibitedFilled(ctx, &ctx.Needle, ctx.Present()) {
+		return "", true
+	}
+
+	for _, path := range ctx.Args {
+		present := ctx.FieldPresent(path)
+		if prohibitedFilled(ctx, ctx.Field(path), present) {
+			// Return directly so the :other message variable refers to this field
+			return "prohibits", false
 		}
 	}
 
@@ -365,9 +815,14 @@ func NotNil(ctx *ValidatorCtx) (string, bool) {
 }
 
 func Accepted(ctx *ValidatorCtx) (string, bool) {
-	ctx.UnwrapPointer()
+	return accepted(&ctx.Needle)
+}
 
-	if !ctx.IsKind(
+// accepted checks if the needle contains a value that is considered accepted, see the accepted validator
+func accepted(n *Needle) (string, bool) {
+	n.UnwrapPointer()
+
+	if !n.IsKind(
 		reflect.Bool,
 		reflect.String,
 		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -376,28 +831,28 @@ func Accepted(ctx *ValidatorCtx) (string, bool) {
 		return "invalid_type", false
 	}
 
-	if !ctx.HasValue() {
+	if !n.HasValue() {
 		return "unacceptable", false
 	}
 
-	switch ctx.Kind() {
+	switch n.Kind() {
 	case reflect.Bool:
-		if !ctx.Value.Bool() {
+		if !n.Value.Bool() {
 			return "unacceptable", false
 		}
 	case reflect.String:
-		switch ctx.Value.String() {
+		switch n.Value.String() {
 		case "yes", "on", "1", "true":
 			return "", true
 		}
 		return "unacceptable", false
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if ctx.Value.Int() == 1 {
+		if n.Value.Int() == 1 {
 			return "", true
 		}
 		return "unacceptable", false
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if ctx.Value.Uint() == 1 {
+		if n.Value.Uint() == 1 {
 			return "", true
 		}
 		return "unacceptable", false
@@ -408,10 +863,27 @@ func Accepted(ctx *ValidatorCtx) (string, bool) {
 	return "", true
 }
 
+func AcceptedIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Accepted(ctx)
+}
+
 func Declined(ctx *ValidatorCtx) (string, bool) {
-	ctx.UnwrapPointer()
+	return declined(&ctx.Needle)
+}
 
-	if !ctx.IsKind(
+// declined checks if the needle contains a value that is considered declined, see the declined validator
+func declined(n *Needle) (string, bool) {
+	n.UnwrapPointer()
+
+	if !n.IsKind(
 		reflect.Bool,
 		reflect.String,
 		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -420,28 +892,28 @@ func Declined(ctx *ValidatorCtx) (string, bool) {
 		return "invalid_type", false
 	}
 
-	if !ctx.HasValue() {
+	if !n.HasValue() {
 		return "unacceptable", false
 	}
 
-	switch ctx.Kind() {
+	switch n.Kind() {
 	case reflect.Bool:
-		if ctx.Value.Bool() {
+		if n.Value.Bool() {
 			return "unacceptable", false
 		}
 	case reflect.String:
-		switch ctx.Value.String() {
+		switch n.Value.String() {
 		case "no", "off", "0", "false":
 			return "", true
 		}
 		return "unacceptable", false
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if ctx.Value.Int() == 0 {
+		if n.Value.Int() == 0 {
 			return "", true
 		}
 		return "unacceptable", false
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if ctx.Value.Uint() == 0 {
+		if n.Value.Uint() == 0 {
 			return "", true
 		}
 		return "unacceptable", false
@@ -452,6 +924,18 @@ func Declined(ctx *ValidatorCtx) (string, bool) {
 	return "", true
 }
 
+func DeclinedIf(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) < 2 {
+		return "", true
+	}
+
+	if !equalsAnyArg(ctx.Field(ctx.Args[0]), ctx.Args[1:]) {
+		return "", true
+	}
+
+	return Declined(ctx)
+}
+
 func Boolean(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
 
@@ -680,8 +1164,7 @@ func Numeric(ctx *ValidatorCtx) (string, bool) {
 			return status.Response()
 		}
 
-		_, err := strconv.Atoi(str)
-		if err != nil {
+		if !isNumericString(str) {
 			return "not_numeric", false
 		}
 		return "", true
@@ -690,8 +1173,173 @@ func Numeric(ctx *ValidatorCtx) (string, bool) {
 	}
 }
 
+func Integer(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	if ctx.IsInt() || ctx.IsUint() {
+		return "", true
+	}
+
+	if len(ctx.Args) > 0 && ctx.Args[0] == "strict" {
+		return "integer", false
+	}
+
+	switch ctx.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if !ctx.HasValue() {
+			return "", true
+		}
+
+		value := ctx.Value.Float()
+		if math.Trunc(value) == value && !math.IsInf(value, 0) {
+			return "", true
+		}
+		return "integer", false
+	case reflect.String:
+		str, status := ctx.String()
+		if !status.Oke() {
+			return status.Response()
+		}
+
+		_, err := strconv.ParseInt(strings.Trim(str, numericWhitespace), 10, 64)
+		if err != nil {
+			return "integer", false
+		}
+		return "", true
+	default:
+		return "integer", false
+	}
+}
+
+func Decimal(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	hint := "decimal"
+	if len(ctx.Args) > 1 {
+		hint = "between"
+	}
+
+	number, status := numberString(&ctx.Needle)
+	if status == ValueNil {
+		return "", true
+	}
+	if !status.Oke() {
+		return hint, false
+	}
+
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	prepared, err := ctx.Prepared(prepareIntegers)
+	if err != nil {
+		return "args", false
+	}
+	places := prepared.([]int)
+
+	min := places[0]
+	max := min
+	if len(places) > 1 {
+		max = places[1]
+	}
+
+	if strings.ContainsAny(number, "eE") {
+		// Laravel does not allow the exponent notation for decimals
+		return hint, false
+	}
+
+	numberPlaces := 0
+	dotIdx := strings.IndexByte(number, '.')
+	if dotIdx != -1 {
+		numberPlaces = len(number) - dotIdx - 1
+	}
+
+	if numberPlaces < min || numberPlaces > max {
+		return hint, false
+	}
+
+	return "", true
+}
+
+// maxMultipleOfExponent limits the exponent of numbers checked by the multiple_of rule
+// as math/big would otherwise allocate huge numbers for values like 1e999999999
+const maxMultipleOfExponent = 1000
+
+// prepareMultipleOf parses the divisor of the multiple_of rule
+func prepareMultipleOf(args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing divisor")
+	}
+
+	divisorArg, ok := trimNumericString(args[0])
+	if !ok || !exponentWithinLimit(divisorArg) {
+		return nil, fmt.Errorf("%q is not a number", args[0])
+	}
+
+	divisor, ok := new(big.Rat).SetString(divisorArg)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a number", args[0])
+	}
+	return divisor, nil
+}
+
+func MultipleOf(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	number, status := numberString(&ctx.Needle)
+	if status == ValueNil {
+		return "", true
+	}
+	if !status.Oke() {
+		return "multiple_of", false
+	}
+
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	prepared, err := ctx.Prepared(prepareMultipleOf)
+	if err != nil {
+		return "args", false
+	}
+	divisor := prepared.(*big.Rat)
+
+	if divisor.Sign() == 0 || !exponentWithinLimit(number) {
+		return "multiple_of", false
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return "multiple_of", false
+	}
+
+	if !value.Quo(value, divisor).IsInt() {
+		return "multiple_of", false
+	}
+
+	return "", true
+}
+
+// exponentWithinLimit checks if the exponent of a numeric string is within maxMultipleOfExponent
+func exponentWithinLimit(number string) bool {
+	idx := strings.IndexAny(number, "eE")
+	if idx == -1 {
+		return true
+	}
+
+	exponent, err := strconv.Atoi(number[idx+1:])
+	return err == nil && exponent >= -maxMultipleOfExponent && exponent <= maxMultipleOfExponent
+}
+
 func Max(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.IsFile() {
+		kilobytes, args, ok := fileSizeWithArgs(ctx, 1)
+		if ok && kilobytes > args[0] {
+			return "file", false
+		}
+		return "", true
+	}
 	if !ctx.IsNumeric() && !ctx.HasLen() {
 		return "unsupported_type", false
 	}
@@ -742,6 +1390,13 @@ func Max(ctx *ValidatorCtx) (string, bool) {
 
 func Min(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.IsFile() {
+		kilobytes, args, ok := fileSizeWithArgs(ctx, 1)
+		if ok && kilobytes < args[0] {
+			return "file", false
+		}
+		return "", true
+	}
 	if !ctx.IsNumeric() && !ctx.HasLen() {
 		return "unsupported_type", false
 	}
@@ -792,6 +1447,13 @@ func Min(ctx *ValidatorCtx) (string, bool) {
 
 func Between(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.IsFile() {
+		kilobytes, args, ok := fileSizeWithArgs(ctx, 2)
+		if ok && (kilobytes < args[0] || kilobytes > args[1]) {
+			return "file", false
+		}
+		return "", true
+	}
 	if !ctx.IsNumeric() && !ctx.HasLen() {
 		return "unsupported_type", false
 	}
@@ -855,6 +1517,13 @@ func Between(ctx *ValidatorCtx) (string, bool) {
 
 func Size(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.IsFile() {
+		kilobytes, args, ok := fileSizeWithArgs(ctx, 1)
+		if ok && kilobytes != args[0] {
+			return "file", false
+		}
+		return "", true
+	}
 	if !ctx.IsInt() && !ctx.IsUint() && !ctx.HasLen() {
 		return "unsupported_type", false
 	}
@@ -891,6 +1560,26 @@ func Size(ctx *ValidatorCtx) (string, bool) {
 	}
 }
 
+// fileSizeWithArgs returns the size of the uploaded file in kilobytes and the first argsCount validator args as floats
+// ok is false if there is no file or if the args are missing or invalid, in that case the validator should pass
+func fileSizeWithArgs(ctx *ValidatorCtx, argsCount int) (kilobytes float64, args []float64, ok bool) {
+	file, status := ctx.File()
+	if !status.Oke() || len(ctx.Args) < argsCount {
+		return 0, nil, false
+	}
+
+	args = make([]float64, argsCount)
+	for idx := range args {
+		arg, err := strconv.ParseFloat(ctx.Args[idx], 64)
+		if err != nil {
+			return 0, nil, false
+		}
+		args[idx] = arg
+	}
+
+	return float64(file.Size) / 1024, args, true
+}
+
 func StartsWith(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
 	str, status := ctx.StringLike()
@@ -1000,6 +1689,42 @@ func IPV6(ctx *ValidatorCtx) (string, bool) {
 	return "", true
 }
 
+// prepareRegex compiles the patterns of the regex and not_regex rules, patterns must be wrapped in slashes like /^[a-z]+$/
+// The args are split on commas so a pattern containing a comma is joined back together
+func prepareRegex(args []string) (any, error) {
+	patterns := []*regexp.Regexp{}
+	pattern := ""
+	joining := false
+	for _, arg := range args {
+		if joining {
+			pattern += "," + arg
+		} else {
+			pattern = arg
+		}
+
+		if !strings.HasPrefix(pattern, "/") {
+			return nil, fmt.Errorf("pattern %q must be wrapped in slashes", pattern)
+		}
+		if len(pattern) < 2 || !strings.HasSuffix(pattern, "/") {
+			joining = true
+			continue
+		}
+		joining = false
+
+		compiled, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, compiled)
+	}
+
+	if joining {
+		return nil, fmt.Errorf("pattern %q must be wrapped in slashes", pattern)
+	}
+
+	return patterns, nil
+}
+
 func Regex(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
 	str, status := ctx.String()
@@ -1011,17 +1736,13 @@ func Regex(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	for _, pattern := range ctx.Args {
-		if len(pattern) < 2 || !strings.HasPrefix(pattern, "/") || !strings.HasSuffix(pattern, "/") {
-			continue
-		}
-		pattern = pattern[1 : len(pattern)-1]
+	patterns, err := ctx.Prepared(prepareRegex)
+	if err != nil {
+		return "args", false
+	}
 
-		matched, err := regexp.MatchString(pattern, str)
-		if err != nil {
-			return "invalid", false
-		}
-		if matched {
+	for _, pattern := range patterns.([]*regexp.Regexp) {
+		if pattern.MatchString(str) {
 			return "", true
 		}
 	}
@@ -1040,22 +1761,53 @@ func NotRegex(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	for _, pattern := range ctx.Args {
-		if len(pattern) < 2 || !strings.HasPrefix(pattern, "/") || !strings.HasSuffix(pattern, "/") {
-			continue
+	patterns, err := ctx.Prepared(prepareRegex)
+	if err != nil {
+		return "args", false
+	}
+
+	for _, pattern := range patterns.([]*regexp.Regexp) {
+		if pattern.MatchString(str) {
+			return "matched", false
 		}
-		pattern = pattern[1 : len(pattern)-1]
+	}
 
-		matched, err := regexp.MatchString(pattern, str)
+	return "", true
+}
+
+// prepareNumbers checks that the args of rules like max and between are numbers
+func prepareNumbers(args []string) (any, error) {
+	numbers := make([]float64, len(args))
+	for idx, arg := range args {
+		number, err := strconv.ParseFloat(arg, 64)
 		if err != nil {
-			return "invalid", false
+			return nil, fmt.Errorf("%q is not a number", arg)
 		}
-		if matched {
-			return "matched", false
+		numbers[idx] = number
+	}
+	return numbers, nil
+}
+
+// prepareIntegers checks that the args of rules like digits and decimal are integers
+func prepareIntegers(args []string) (any, error) {
+	integers := make([]int, len(args))
+	for idx, arg := range args {
+		integer, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", arg)
 		}
+		integers[idx] = integer
 	}
+	return integers, nil
+}
 
-	return "", true
+// prepareSet turns the args of the in and not_in rules into a set
+func prepareSet(args []string) (any, error) {
+	set := make(map[string]struct{}, len(args))
+	for _, arg := range args {
+		set[arg] = struct{}{}
+	}
+	return set, nil
 }
 
 func In(ctx *ValidatorCtx) (string, bool) {
@@ -1084,10 +1836,9 @@ func In(ctx *ValidatorCtx) (string, bool) {
 		return "not_in", false
 	}
 
-	for _, allowedValue := range ctx.Args {
-		if val == allowedValue {
-			return "", true
-		}
+	allowed, _ := ctx.Prepared(prepareSet)
+	if _, ok := allowed.(map[string]struct{})[val]; ok {
+		return "", true
 	}
 
 	return "not_in", false
@@ -1119,9 +1870,69 @@ func NotIn(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	for _, allowedValue := range ctx.Args {
-		if val == allowedValue {
-			return "in", false
+	disallowed, _ := ctx.Prepared(prepareSet)
+	if _, ok := disallowed.(map[string]struct{})[val]; ok {
+		return "in", false
+	}
+
+	return "", true
+}
+
+func InArray(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	key, ok := distinctKey(&ctx.Needle, false, false)
+	if !ok {
+		return "", true
+	}
+
+	other := ctx.Field(ctx.Args[0])
+	if other == nil {
+		return "field_missing", false
+	}
+
+	other.UnwrapPointer()
+	if !other.HasValue() || !other.IsList() {
+		return "in_array", false
+	}
+
+	for idx := 0; idx < other.Value.Len(); idx++ {
+		element := other.Value.Index(idx)
+		elementKey, ok := distinctKey(&Needle{Type: element.Type(), Value: &element}, false, false)
+		if ok && elementKey == key {
+			return "", true
+		}
+	}
+
+	return "in_array", false
+}
+
+func RequiredArrayKeys(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	if ctx.Kind() != reflect.Map {
+		return "invalid_type", false
+	}
+
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	if !ctx.HasValue() || ctx.Value.IsNil() {
+		return "required_array_keys", false
+	}
+
+	// The keys are compared using their formatted value so named and numeric key types work as well
+	keys := map[string]struct{}{}
+	for _, key := range ctx.Value.MapKeys() {
+		keys[formatMapKey(key)] = struct{}{}
+	}
+
+	for _, key := range ctx.Args {
+		if _, ok := keys[key]; !ok {
+			return "required_array_keys", false
 		}
 	}
 
@@ -1130,6 +1941,10 @@ func NotIn(ctx *ValidatorCtx) (string, bool) {
 
 func Mimetypes(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.Kind() != reflect.String {
+		return validateContentType(ctx, ctx.Args)
+	}
+
 	mimetype, status := ctx.String()
 	if !status.Oke() {
 		return status.Response()
@@ -1158,6 +1973,17 @@ func Mimetypes(ctx *ValidatorCtx) (string, bool) {
 
 func Mimes(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
+	if ctx.Kind() != reflect.String {
+		allowedMimetypes := []string{}
+		for _, allowedExtension := range ctx.Args {
+			allowedMimetype, ok := extensions[strings.ToLower(strings.TrimPrefix(allowedExtension, "."))]
+			if ok {
+				allowedMimetypes = append(allowedMimetypes, allowedMimetype)
+			}
+		}
+		return validateContentType(ctx, allowedMimetypes)
+	}
+
 	mimetype, status := ctx.String()
 	if !status.Oke() {
 		return status.Response()
@@ -1178,7 +2004,33 @@ func Mimes(ctx *ValidatorCtx) (string, bool) {
 		}
 	}
 
-	return "mimetype", false
+	return "mimetype", false
+}
+
+// validateContentType detects the content type of the value and checks if it matches one of the allowed mimetypes
+func validateContentType(ctx *ValidatorCtx, allowedMimetypes []string) (string, bool) {
+	contentType, status := ctx.ContentType()
+	if !status.Oke() {
+		return status.Response()
+	}
+
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	for _, allowedMimetype := range allowedMimetypes {
+		if contentTypeMatches(contentType.Detected, allowedMimetype) {
+			return "", true
+		}
+	}
+
+	ctx.SetMessageVariable("detected", contentType.Detected)
+	if contentType.Declared == "" {
+		return "detected", false
+	}
+
+	ctx.SetMessageVariable("declared", contentType.Declared)
+	return "declared", false
 }
 
 type DigitsStatus uint8
@@ -1348,6 +2200,29 @@ func DigitsBetween(ctx *ValidatorCtx) (string, bool) {
 	return "", true
 }
 
+// prepareDate parses the date argument of the after, after_or_equal, before and before_or_equal rules
+func prepareDate(args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	expression, ok := parseDateExpression(args[0])
+	if !ok {
+		return nil, fmt.Errorf("invalid date %q", args[0])
+	}
+	return expression, nil
+}
+
+// dateArg returns the date argument of a date rule relative to the current time
+func dateArg(ctx *ValidatorCtx) (time.Time, bool) {
+	expression, err := ctx.Prepared(prepareDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return expression.(dateExpression)(time.Now()), true
+}
+
 func AfterDate(ctx *ValidatorCtx) (string, bool) {
 	ctx.UnwrapPointer()
 
@@ -1360,7 +2235,7 @@ func AfterDate(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	argDate, ok := ctx.DateFromArgs(0)
+	argDate, ok := dateArg(ctx)
 	if !ok {
 		return "invalid_param", false
 	}
@@ -1384,7 +2259,7 @@ func AfterOrEqualDate(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	argDate, ok := ctx.DateFromArgs(0)
+	argDate, ok := dateArg(ctx)
 	if !ok {
 		return "invalid_param", false
 	}
@@ -1408,7 +2283,7 @@ func BeforeDate(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	argDate, ok := ctx.DateFromArgs(0)
+	argDate, ok := dateArg(ctx)
 	if !ok {
 		return "invalid_param", false
 	}
@@ -1432,7 +2307,7 @@ func BeforeOrEqualDate(ctx *ValidatorCtx) (string, bool) {
 		return "", true
 	}
 
-	argDate, ok := ctx.DateFromArgs(0)
+	argDate, ok := dateArg(ctx)
 	if !ok {
 		return "invalid_param", false
 	}
@@ -1508,24 +2383,12 @@ func Email(ctx *ValidatorCtx) (string, bool) {
 }
 
 func Filled(ctx *ValidatorCtx) (string, bool) {
-	ctx.UnwrapPointer()
-
-	if !ctx.HasValue() {
+	if !ctx.Present() {
 		return "", true
 	}
 
-	switch ctx.Kind() {
-	case reflect.Map, reflect.Slice:
-		if ctx.Value.IsNil() {
-			return "", true
-		}
-		if ctx.Value.Len() == 0 {
-			return "required", false
-		}
-	case reflect.String:
-		if ctx.Value.String() == "" {
-			return "required", false
-		}
+	if empty(&ctx.Needle) {
+		return "required", false
 	}
 
 	return "", true
@@ -1556,8 +2419,8 @@ func DateFormat(ctx *ValidatorCtx) (string, bool) {
 
 	for _, format := range ctx.Args {
 		t, err := time.Parse(format, str)
-		if err != nil {
-			ctx.SetState("parsed_date", t)
+		if err == nil {
+			ctx.SetState(ParsedDateKey, t)
 			return "", true
 		}
 	}
@@ -1712,6 +2575,189 @@ func Confirmed(ctx *ValidatorCtx) (string, bool) {
 	return "", true
 }
 
+func Same(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	ctx.UnwrapPointer()
+
+	other := ctx.Field(ctx.Args[0])
+	if other == nil {
+		return "field_missing", false
+	}
+
+	other.UnwrapPointer()
+
+	if !ctx.HasValue() || !other.HasValue() {
+		if ctx.HasValue() == other.HasValue() {
+			return "", true
+		}
+		return "not_equal", false
+	}
+
+	if !equal(*ctx.Value, *other.Value) {
+		return "not_equal", false
+	}
+
+	return "", true
+}
+
+func Different(ctx *ValidatorCtx) (string, bool) {
+	if len(ctx.Args) == 0 {
+		return "", true
+	}
+
+	ctx.UnwrapPointer()
+
+	other := ctx.Field(ctx.Args[0])
+	if other == nil {
+		return "field_missing", false
+	}
+
+	other.UnwrapPointer()
+
+	if !ctx.HasValue() || !other.HasValue() {
+		return "", true
+	}
+
+	if equal(*ctx.Value, *other.Value) {
+		return "equal", false
+	}
+
+	return "", true
+}
+
+func Distinct(ctx *ValidatorCtx) (string, bool) {
+	strict := false
+	ignoreCase := false
+	for _, arg := range ctx.Args {
+		switch arg {
+		case "strict":
+			strict = true
+		case "ignore_case":
+			ignoreCase = true
+		}
+	}
+
+	ctx.UnwrapPointer()
+
+	if ctx.IsList() {
+		// The validator is applied to the list itself, report every duplicated element
+		if !ctx.HasValue() {
+			return "", true
+		}
+
+		keys := make([]string, ctx.Value.Len())
+		hasKey := make([]bool, ctx.Value.Len())
+		counts := map[string]int{}
+		for idx := range keys {
+			element := ctx.Value.Index(idx)
+			keys[idx], hasKey[idx] = distinctKey(&Needle{Type: element.Type(), Value: &element}, strict, ignoreCase)
+			if hasKey[idx] {
+				counts[keys[idx]]++
+			}
+		}
+
+		for idx, key := range keys {
+			if hasKey[idx] && counts[key] > 1 {
+				ctx.ElementError(idx, "distinct")
+			}
+		}
+
+		return "", true
+	}
+
+	key, ok := distinctKey(&ctx.Needle, strict, ignoreCase)
+	if !ok {
+		return "", true
+	}
+
+	// Compare the value with the same field of the other elements of the closest list
+	stack := ctx.Stack()
+	for listIdx := len(stack) - 1; listIdx >= 0; listIdx-- {
+		listElement := stack[listIdx]
+		if listElement.Kind != StackKindList {
+			continue
+		}
+
+		if listElement.Parent == nil {
+			return "", true
+		}
+
+		path := []string{}
+		for _, element := range stack[listIdx+1:] {
+			path = append(path, element.GoName)
+		}
+
+		list := *listElement.Parent
+		for idx := 0; idx < list.Len(); idx++ {
+			if idx == listElement.Index {
+				continue
+			}
+
+			other := resolveWithValue(list.Index(idx), path)
+			if other == nil {
+				continue
+			}
+
+			otherKey, ok := distinctKey(other, strict, ignoreCase)
+			if ok && otherKey == key {
+				return "distinct", false
+			}
+		}
+
+		return "", true
+	}
+
+	return "", true
+}
+
+// distinctKey returns a key for a value that can be used to detect duplicates
+// If strict is false values of different types with the same representation are considered equal, for example "1" and 1
+func distinctKey(n *Needle, strict bool, ignoreCase bool) (string, bool) {
+	n.UnwrapPointer()
+	if !n.HasValue() {
+		return "", false
+	}
+
+	value := *n.Value
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", false
+		}
+		value = value.Elem()
+	}
+
+	var key string
+	switch value.Kind() {
+	case reflect.String:
+		key = value.String()
+		if ignoreCase {
+			key = strings.ToLower(key)
+		}
+	case reflect.Bool:
+		key = strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		key = strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		key = strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		key = strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	default:
+		if !value.CanInterface() {
+			return "", false
+		}
+		key = fmt.Sprintf("%+v", value.Interface())
+	}
+
+	if strict {
+		key = value.Type().String() + ":" + key
+	}
+
+	return key, true
+}
+
 type SizeCompareStatus uint8
 
 const (
@@ -1869,3 +2915,285 @@ func Lte(ctx *ValidatorCtx) (string, bool) {
 
 	return "", true
 }
+
+func Enum(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	values, hasValues := ctx.EnumValues()
+	hint := "enum"
+	if hasValues {
+		hint = "values"
+
+		formattedValues := make([]string, len(values))
+		for idx, value := range values {
+			formattedValues[idx] = formatEnumValue(value)
+		}
+		ctx.SetMessageVariable("values", strings.Join(formattedValues, ", "))
+	}
+
+	valid, implementsValidator := ctx.EnumIsValid()
+	if !hasValues && !implementsValidator {
+		return "invalid_type", false
+	}
+
+	if !ctx.HasValue() {
+		return "", true
+	}
+
+	if implementsValidator {
+		if !valid {
+			return hint, false
+		}
+		return "", true
+	}
+
+	for _, value := range values {
+		if equal(*ctx.Value, value) {
+			return "", true
+		}
+	}
+
+	return hint, false
+}
+
+// formatEnumValue formats a enum value based on its underlying kind so String() methods are ignored
+func formatEnumValue(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	}
+
+	if !value.CanInterface() {
+		return ""
+	}
+	return fmt.Sprintf("%+v", value.Interface())
+}
+
+// passwordOption is a parsed arg of the password rule, length is only set for the min and max options
+type passwordOption struct {
+	name   string
+	length int
+}
+
+// preparePassword parses the args of the password rule, unknown options and lengths that are not integers are invalid
+func preparePassword(args []string) (any, error) {
+	options := make([]passwordOption, len(args))
+	for idx, arg := range args {
+		name, value, hasValue := strings.Cut(arg, "=")
+
+		switch name {
+		case "min", "max":
+			length, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", arg)
+			}
+			options[idx] = passwordOption{name: name, length: length}
+		case "letters", "mixed", "numbers", "symbols", "uncompromised":
+			if hasValue {
+				return nil, fmt.Errorf("%q does not accept a value", name)
+			}
+			options[idx] = passwordOption{name: name}
+		default:
+			return nil, fmt.Errorf("unknown password option %q", arg)
+		}
+	}
+	return options, nil
+}
+
+// Password validates the strength of a password
+//
+// Supported arguments:
+//   - min=12 = The password must be at least 12 characters
+//   - max=64 = The password must not be more than 64 characters
+//   - letters = The password must contain at least one letter
+//   - mixed = The password must contain at least one uppercase and one lowercase letter
+//   - numbers = The password must contain at least one number
+//   - symbols = The password must contain at least one symbol
+//   - uncompromised = The password must not appear in a data leak, see SetCompromisedPasswordChecker
+//
+// Unknown arguments are invalid, see preparePassword
+func Password(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	password, status := ctx.String()
+	if !status.Oke() {
+		return status.Response()
+	}
+
+	var hasLetter, hasUpper, hasLower, hasNumber, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsLetter(c):
+			hasLetter = true
+			if unicode.IsUpper(c) {
+				hasUpper = true
+			} else if unicode.IsLower(c) {
+				hasLower = true
+			}
+		case unicode.IsNumber(c):
+			hasNumber = true
+		case unicode.IsSymbol(c), unicode.IsPunct(c), unicode.Is(unicode.Z, c):
+			hasSymbol = true
+		}
+	}
+
+	options, err := ctx.Prepared(preparePassword)
+	if err != nil {
+		return "args", false
+	}
+
+	uncompromised := false
+	for _, option := range options.([]passwordOption) {
+		switch option.name {
+		case "min", "max":
+			passwordLength := utf8.RuneCountInString(password)
+			if (option.name == "min" && passwordLength < option.length) || (option.name == "max" && passwordLength > option.length) {
+				ctx.SetMessageVariable(option.name, strconv.Itoa(option.length))
+				return option.name, false
+			}
+		case "letters":
+			if !hasLetter {
+				return "letters", false
+			}
+		case "mixed":
+			if !hasUpper || !hasLower {
+				return "mixed", false
+			}
+		case "numbers":
+			if !hasNumber {
+				return "numbers", false
+			}
+		case "symbols":
+			if !hasSymbol {
+				return "symbols", false
+			}
+		case "uncompromised":
+			uncompromised = true
+		}
+	}
+
+	// The compromised check is done last as it might be expensive
+	checker := ctx.state.validator.instance.passwordChecker()
+	if uncompromised && checker != nil {
+		compromised, err := checker.Compromised(ctx.Context(), password)
+		// Like Laravel a failing checker does not block the password
+		if err == nil && compromised {
+			return "uncompromised", false
+		}
+	}
+
+	return "", true
+}
+
+func File(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	_, status := ctx.File()
+	if !status.Oke() {
+		return status.Response()
+	}
+
+	return "", true
+}
+
+func Image(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	_, status := ctx.ImageConfig()
+	if !status.Oke() {
+		return status.Response()
+	}
+
+	return "", true
+}
+
+// Dimensions validates the dimensions of an uploaded image
+//
+// Supported arguments:
+//   - width=100, height=100 = The image must be exactly this wide or high
+//   - min_width=100, min_height=100 = The image must be at least this wide or high
+//   - max_width=100, max_height=100 = The image must not be wider or higher
+//   - ratio=3/2 = The image must have this width / height ratio, can also be written as a float (1.5)
+func Dimensions(ctx *ValidatorCtx) (string, bool) {
+	ctx.UnwrapPointer()
+
+	config, status := ctx.ImageConfig()
+	if !status.Oke() {
+		return status.Response()
+	}
+
+	width, height := config.Width, config.Height
+	for _, arg := range ctx.Args {
+		name, value, _ := strings.Cut(arg, "=")
+
+		if name == "ratio" {
+			ratio, ok := parseRatio(value)
+			if !ok {
+				continue
+			}
+
+			// Same precision as Laravel so rounded dimensions like 1366x768 match 16/9
+			if height == 0 || math.Abs(ratio-float64(width)/float64(height)) > 1/(float64(max(width, height))+1) {
+				return "dimensions", false
+			}
+			continue
+		}
+
+		expected, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+
+		var valid bool
+		switch name {
+		case "width":
+			valid = width == expected
+		case "height":
+			valid = height == expected
+		case "min_width":
+			valid = width >= expected
+		case "min_height":
+			valid = height >= expected
+		case "max_width":
+			valid = width <= expected
+		case "max_height":
+			valid = height <= expected
+		default:
+			continue
+		}
+
+		if !valid {
+			return "dimensions", false
+		}
+	}
+
+	return "", true
+}
+
+// parseRatio parses a ratio written as a fraction (3/2) or a float (1.5)
+func parseRatio(value string) (float64, bool) {
+	numerator, denominator, isFraction := strings.Cut(value, "/")
+	if !isFraction {
+		ratio, err := strconv.ParseFloat(value, 64)
+		return ratio, err == nil && ratio > 0
+	}
+
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil {
+		return 0, false
+	}
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil || d == 0 {
+		return 0, false
+	}
+
+	return n / d, n > 0
+}
@@ -1,7 +1,14 @@
 package laravalidate
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -24,7 +31,7 @@ func validatorCtx(value *reflect.Value, valueType reflect.Type, args []string) *
 			bail:      false,
 			state:     map[string]any{},
 			stack:     Stack{},
-			validator: newValidator(ctx, nil, reflect.ValueOf(struct{}{}), GoMode),
+			validator: defaultInstance.newValidator(ctx, nil, reflect.ValueOf(struct{}{}), GoMode),
 		},
 	}
 }
@@ -78,7 +85,7 @@ func TestRulesFuzz(t *testing.T) {
 		},
 	}
 
-	for name, validator := range validators {
+	for name, validator := range defaultInstance.validators {
 		t.Run(name, func(t *testing.T) {
 			for _, arg := range args {
 				for _, value := range values {
@@ -132,6 +139,126 @@ func TestRegex(t *testing.T) {
 	validationRuleInvalid(t, NotRegex, "foo", []string{"/^foo$/"})
 	validationRuleInvalid(t, Regex, "foo", []string{"/^bar$/"})
 	validationRulePasses(t, NotRegex, "foo", []string{"/^bar$/"})
+
+	// Patterns containing a comma are split into multiple args
+	validationRulePasses(t, Regex, "aa", []string{"/^a{1", "3}$/"})
+	validationRuleInvalid(t, Regex, "aaaa", []string{"/^a{1", "3}$/"})
+	validationRulePasses(t, Regex, "bar", []string{"/^foo$/", "/^bar$/"})
+
+	_, err := prepareRegex([]string{"/[a-z/"})
+	assert.Error(t, err)
+	_, err = prepareRegex([]string{"^foo$"})
+	assert.Error(t, err)
+	_, err = prepareRegex([]string{"/^foo"})
+	assert.Error(t, err)
+}
+
+func TestIn(t *testing.T) {
+	validationRulePasses(t, In, "b", []string{"a", "b"})
+	validationRulePasses(t, In, 2, []string{"1", "2"})
+	validationRuleInvalid(t, In, "c", []string{"a", "b"})
+	validationRulePasses(t, NotIn, "c", []string{"a", "b"})
+	validationRuleInvalid(t, NotIn, uint(1), []string{"1", "2"})
+}
+
+func TestDateRules(t *testing.T) {
+	validationRulePasses(t, AfterDate, "2030-01-02", []string{"2030-01-01"})
+	validationRuleInvalid(t, AfterDate, "2030-01-01", []string{"2030-01-01"})
+	validationRulePasses(t, AfterOrEqualDate, "2030-01-01", []string{"2030-01-01"})
+	validationRulePasses(t, BeforeDate, "2000-01-01", []string{"today"})
+	validationRuleInvalid(t, BeforeDate, "2000-01-01", []string{"first day of january 1999"})
+	validationRulePasses(t, BeforeOrEqualDate, "2000-01-01", []string{"2000-01-01"})
+	validationRuleInvalid(t, AfterDate, "2000-01-01", []string{"not a date"})
+
+	validationRulePasses(t, DateFormat, "2024-02-03", []string{"2006-01-02"})
+	validationRuleInvalid(t, DateFormat, "03/02/2024", []string{"2006-01-02"})
+}
+
+func TestDateExpression(t *testing.T) {
+	now := time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC) // A wednesday
+
+	testCases := map[string]time.Time{
+		"now":                          now,
+		"today":                        time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC),
+		"tomorrow":                     time.Date(2024, time.May, 16, 0, 0, 0, 0, time.UTC),
+		"Yesterday":                    time.Date(2024, time.May, 14, 0, 0, 0, 0, time.UTC),
+		"friday":                       time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC),
+		"+2 days":                      now.AddDate(0, 0, 2),
+		"-1 week":                      now.AddDate(0, 0, -7),
+		"2 weekdays":                   time.Date(2024, time.May, 17, 13, 30, 0, 0, time.UTC),
+		"3 weekdays":                   time.Date(2024, time.May, 20, 13, 30, 0, 0, time.UTC),
+		"-3 weekdays":                  time.Date(2024, time.May, 10, 13, 30, 0, 0, time.UTC),
+		"monday next week":             time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC),
+		"first day of next month":      time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		"last day of february 2024":    time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"first monday of january 2024": time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"2020-01-02":                   time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	for arg, expected := range testCases {
+		expression, ok := parseDateExpression(arg)
+		if assert.Truef(t, ok, "arg=%s", arg) {
+			assert.Equalf(t, expected, expression(now), "arg=%s", arg)
+		}
+	}
+
+	_, ok := parseDateExpression("the day after")
+	assert.False(t, ok)
+}
+
+func TestDateExpressionWeeks(t *testing.T) {
+	// "[weekday] next week" is the weekday of the week after the current week, counted from the start of today
+	testCases := []struct {
+		now      time.Time
+		arg      string
+		expected time.Time
+	}{
+		{time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC), "monday next week", time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC), "friday next week", time.Date(2024, time.May, 24, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 18, 13, 30, 0, 0, time.UTC), "sunday next week", time.Date(2024, time.May, 19, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 19, 13, 30, 0, 0, time.UTC), "saturday next week", time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC), "monday this week", time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC), "friday last week", time.Date(2024, time.May, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, testCase := range testCases {
+		expression, ok := parseDateExpression(testCase.arg)
+		if assert.Truef(t, ok, "arg=%s", testCase.arg) {
+			assert.Equalf(t, testCase.expected, expression(testCase.now), "arg=%s now=%s", testCase.arg, testCase.now)
+		}
+	}
+}
+
+func TestDateExpressionNegativeWeekdays(t *testing.T) {
+	// Negative weekday offsets move backwards and skip the weekend
+	monday := time.Date(2024, time.May, 13, 13, 30, 0, 0, time.UTC)
+	testCases := map[string]time.Time{
+		"-1 weekday":  time.Date(2024, time.May, 10, 13, 30, 0, 0, time.UTC),
+		"-2 weekdays": time.Date(2024, time.May, 9, 13, 30, 0, 0, time.UTC),
+		"-5 weekdays": time.Date(2024, time.May, 6, 13, 30, 0, 0, time.UTC),
+		"1 weekday":   time.Date(2024, time.May, 14, 13, 30, 0, 0, time.UTC),
+	}
+
+	for arg, expected := range testCases {
+		expression, ok := parseDateExpression(arg)
+		if assert.Truef(t, ok, "arg=%s", arg) {
+			assert.Equalf(t, expected, expression(monday), "arg=%s", arg)
+		}
+	}
+}
+
+func TestDateFormat(t *testing.T) {
+	validationRulePasses(t, DateFormat, "03/02/2024", []string{"2006-01-02", "02/01/2006"})
+	validationRuleInvalid(t, DateFormat, "2024-02-03T10:00", []string{"2006-01-02", "02/01/2006"})
+
+	// The parsed date is used by the date rules after date_format
+	type Body struct {
+		Start string `json:"start" validate:"date_format:02/01/2006|after:2024-01-01"`
+	}
+	v := &testValidator{t}
+	v.AssertValid(Body{Start: "03/02/2024"})
+	v.AssertInvalid(Body{Start: "03/02/2023"})
+	v.AssertInvalid(Body{Start: "2024-02-03"})
 }
 
 func TestIp(t *testing.T) {
@@ -179,3 +306,762 @@ func TestConfirmed(t *testing.T) {
 		FieldConfirmation: "Foo",
 	})
 }
+
+func TestRequiredIf(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Type        string
+		CompanyName string `validate:"required_if:.Type,business,government"`
+	}
+	v.AssertValid(Test{Type: "personal"})
+	v.AssertInvalid(Test{Type: "business"})
+	v.AssertInvalid(Test{Type: "government"})
+	v.AssertValid(Test{Type: "business", CompanyName: "Acme"})
+
+	type Nested struct {
+		Type  string
+		Inner struct {
+			VatNumber *string `validate:"required_if:Type,business"`
+		}
+	}
+	vatNumber := "NL123"
+	v.AssertValid(Nested{Type: "personal"})
+	v.AssertInvalid(Nested{Type: "business"})
+	v.AssertValid(Nested{Type: "business", Inner: struct {
+		VatNumber *string `validate:"required_if:Type,business"`
+	}{&vatNumber}})
+
+	type Unless struct {
+		Type        string
+		CompanyName string `validate:"required_unless:.Type,personal"`
+	}
+	v.AssertValid(Unless{Type: "personal"})
+	v.AssertInvalid(Unless{Type: "business"})
+	v.AssertValid(Unless{Type: "business", CompanyName: "Acme"})
+}
+
+func TestRequiredIfAccepted(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Newsletter bool
+		Email      string `validate:"required_if_accepted:.Newsletter"`
+	}
+	v.AssertValid(Test{})
+	v.AssertInvalid(Test{Newsletter: true})
+	v.AssertValid(Test{Newsletter: true, Email: "john@example.org"})
+
+	type Declined struct {
+		Newsletter string
+		Reason     string `validate:"required_if_declined:.Newsletter"`
+	}
+	v.AssertValid(Declined{Newsletter: "yes"})
+	v.AssertInvalid(Declined{Newsletter: "no"})
+	v.AssertValid(Declined{Newsletter: "no", Reason: "Too many emails"})
+}
+
+func TestRequiredWith(t *testing.T) {
+	v := &testValidator{t}
+
+	type With struct {
+		Street string
+		City   string
+		Zip    string `validate:"required_with:.Street,.City"`
+	}
+	v.AssertValid(With{})
+	v.AssertInvalid(With{Street: "Main street"})
+	v.AssertValid(With{Street: "Main street", Zip: "1234AB"})
+
+	type WithAll struct {
+		Street string
+		City   string
+		Zip    string `validate:"required_with_all:.Street,.City"`
+	}
+	v.AssertValid(WithAll{Street: "Main street"})
+	v.AssertInvalid(WithAll{Street: "Main street", City: "Amsterdam"})
+
+	type Without struct {
+		Email string
+		Phone string `validate:"required_without:.Email"`
+	}
+	v.AssertInvalid(Without{})
+	v.AssertValid(Without{Email: "john@example.org"})
+	v.AssertValid(Without{Phone: "0612345678"})
+
+	type WithoutAll struct {
+		Email string
+		Phone string
+		Post  string `validate:"required_without_all:.Email,.Phone"`
+	}
+	v.AssertInvalid(WithoutAll{})
+	v.AssertValid(WithoutAll{Email: "john@example.org"})
+}
+
+func TestExclude(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Type        string
+		CompanyName string `validate:"exclude_unless:.Type,business|required"`
+	}
+	v.AssertValid(Test{Type: "personal"})
+	v.AssertInvalid(Test{Type: "business"})
+
+	type Address struct {
+		Street string `validate:"required"`
+	}
+	type Nested struct {
+		Shipping bool
+		Address  *Address `validate:"exclude_if:.Shipping,false"`
+	}
+	v.AssertValid(Nested{Address: &Address{}})
+	v.AssertInvalid(Nested{Shipping: true, Address: &Address{}})
+
+	type List struct {
+		Names []string `validateInner:"exclude|required"`
+	}
+	v.AssertValid(List{Names: []string{""}})
+
+	// Excluded fields are reported by Validated and the input is never modified
+	input := &Test{Type: "personal", CompanyName: "Acme"}
+	data, err := Validated(nil, nil, input, JsonMode)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CompanyName"}, data.ExcludedPaths)
+	assert.True(t, data.IsExcluded("CompanyName"))
+	assert.False(t, data.IsExcluded("Type"))
+	assert.Equal(t, "Acme", input.CompanyName)
+
+	names := List{Names: []string{"a", "b"}}
+	data, err = Validated(nil, nil, names, JsonMode)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Names.0", "Names.1"}, data.ExcludedPaths)
+	assert.True(t, data.IsExcluded("Names.1"))
+	assert.Equal(t, []string{"a", "b"}, names.Names)
+
+	type Map struct {
+		Labels map[string]string `validateInner:"exclude"`
+	}
+	labels := &Map{Labels: map[string]string{"bug": "red"}}
+	data, err = Validated(nil, nil, labels, JsonMode)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Labels.bug"}, data.ExcludedPaths)
+	assert.Equal(t, map[string]string{"bug": "red"}, labels.Labels)
+
+	nested := Nested{Address: &Address{Street: "Main"}}
+	data, err = Validated(nil, nil, &nested, JsonMode)
+	assert.NoError(t, err)
+	assert.True(t, data.IsExcluded("Address.Street"))
+	assert.Equal(t, "Main", nested.Address.Street)
+
+	// Fields of nil structs are also reported
+	type Details struct {
+		Note string `validate:"exclude|required"`
+	}
+	type Parent struct {
+		Details *Details
+	}
+	data, err = Validated(nil, nil, Parent{}, JsonMode)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Details.Note"}, data.ExcludedPaths)
+
+	// The raw variants know which fields were present
+	type Raw struct {
+		Age  int    `json:"age" form:"age" validate:"present"`
+		Note string `json:"note" form:"note" validate:"exclude|required"`
+	}
+	raw := Raw{}
+	data, err = ValidatedJsonRaw(nil, nil, []byte(`{"age":0,"note":"hi"}`), &raw)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"note"}, data.ExcludedPaths)
+	assert.Equal(t, "hi", raw.Note)
+
+	_, err = ValidatedJsonRaw(nil, nil, []byte(`{"note":"hi"}`), &Raw{})
+	assert.Error(t, err)
+
+	data, err = ValidatedFormValues(nil, nil, url.Values{"age": {"0"}}, Raw{})
+	assert.NoError(t, err)
+	assert.Equal(t, FormMode, data.Mode)
+	assert.Equal(t, []string{"note"}, data.ExcludedPaths)
+
+	_, err = ValidatedFormValues(nil, nil, url.Values{}, Raw{})
+	assert.Error(t, err)
+
+	type With struct {
+		Email string
+		Phone string `validate:"exclude_with:.Email|required"`
+	}
+	v.AssertValid(With{Email: "john@example.org"})
+	v.AssertInvalid(With{})
+
+	type Without struct {
+		Email string
+		Phone string `validate:"exclude_without:.Email|required"`
+	}
+	v.AssertValid(Without{})
+	v.AssertInvalid(Without{Email: "john@example.org"})
+}
+
+func TestProhibited(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Internal string `validate:"prohibited"`
+	}
+	v.AssertValid(Test{})
+	v.AssertInvalid(Test{Internal: "foo"})
+
+	type If struct {
+		Type     string
+		Discount *int `validate:"prohibited_if:.Type,wholesale"`
+	}
+	discount := 10
+	v.AssertValid(If{Type: "retail", Discount: &discount})
+	v.AssertInvalid(If{Type: "wholesale", Discount: &discount})
+	v.AssertValid(If{Type: "wholesale"})
+
+	type Unless struct {
+		Role  string
+		Admin bool `validate:"prohibited_unless:.Role,owner"`
+	}
+	v.AssertValid(Unless{Role: "owner", Admin: true})
+	v.AssertInvalid(Unless{Role: "guest", Admin: true})
+	v.AssertValid(Unless{Role: "guest"})
+
+	// Zero values of value typed fields count as absent
+	type Count struct {
+		Count int `json:"count" validate:"prohibited"`
+	}
+	v.AssertValid(Count{})
+	v.AssertInvalid(Count{Count: 1})
+
+	zero := 0
+	type Pointer struct {
+		Count *int `json:"count" validate:"prohibited"`
+	}
+	v.AssertInvalid(Pointer{Count: &zero})
+
+	// If the presence is known a present zero value is filled
+	assert.NoError(t, JsonValidateRaw(nil, nil, []byte(`{}`), &Count{}))
+	assert.Error(t, JsonValidateRaw(nil, nil, []byte(`{"count":0}`), &Count{}))
+}
+
+func TestProhibits(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		CouponCode string `validate:"prohibits:.GiftCard"`
+		GiftCard   string
+	}
+	v.AssertValid(Test{})
+	v.AssertValid(Test{CouponCode: "SUMMER"})
+	v.AssertValid(Test{GiftCard: "1234"})
+	v.AssertInvalid(Test{CouponCode: "SUMMER", GiftCard: "1234"})
+
+	type Amount struct {
+		Discount int `validate:"prohibits:.Credit"`
+		Credit   int
+	}
+	v.AssertValid(Amount{Discount: 10})
+	v.AssertInvalid(Amount{Discount: 10, Credit: 5})
+
+	err := JsonValidate(nil, nil, struct {
+		CouponCode string `json:"coupon_code" validate:"prohibits:.GiftCard"`
+		GiftCard   string `json:"gift_card"`
+	}{CouponCode: "SUMMER", GiftCard: "1234"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The coupon_code field prohibits gift_card from being present.", err.Error())
+	assert.Equal(t, "prohibits", err.(*ValidationError).Errors[0].Errors[0].Hint)
+}
+
+func TestPresentAndMissing(t *testing.T) {
+	type Body struct {
+		Type    string `json:"type"`
+		Company *struct {
+			Name string `json:"name"`
+		} `json:"company" validate:"present_if:.Type,business|missing_unless:.Type,business"`
+		Email string  `json:"email"`
+		Phone *string `json:"phone" validate:"missing_with:.Email"`
+	}
+
+	assertValid := func(input string) {
+		assert.NoError(t, JsonValidateRaw(nil, nil, []byte(input), &Body{}), input)
+	}
+	assertInvalid := func(input string) {
+		assert.Error(t, JsonValidateRaw(nil, nil, []byte(input), &Body{}), input)
+	}
+
+	assertValid(`{"type":"personal"}`)
+	assertValid(`{"type":"business","company":null}`)
+	assertInvalid(`{"type":"business"}`)
+	assertInvalid(`{"type":"personal","company":null}`)
+
+	assertValid(`{"email":"john@example.org"}`)
+	assertValid(`{"phone":"0612345678"}`)
+	assertInvalid(`{"email":"","phone":null}`)
+
+	type With struct {
+		Street *string `json:"street"`
+		Zip    *string `json:"zip" validate:"present_with_all:.Street"`
+	}
+	assert.NoError(t, JsonValidateRaw(nil, nil, []byte(`{}`), &With{}))
+	assert.NoError(t, JsonValidateRaw(nil, nil, []byte(`{"street":"Main street","zip":null}`), &With{}))
+	assert.Error(t, JsonValidateRaw(nil, nil, []byte(`{"street":"Main street"}`), &With{}))
+
+	// Without the raw input a field is present when it's not nil
+	v := &testValidator{t}
+	type Present struct {
+		Name *string `validate:"present"`
+	}
+	name := ""
+	v.AssertInvalid(Present{})
+	v.AssertValid(Present{Name: &name})
+}
+
+func TestAcceptedIf(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Country string
+		Terms   bool `validate:"accepted_if:.Country,nl,be"`
+	}
+	v.AssertValid(Test{Country: "de"})
+	v.AssertInvalid(Test{Country: "nl"})
+	v.AssertValid(Test{Country: "be", Terms: true})
+
+	type Declined struct {
+		Adult    bool
+		Tracking string `validate:"declined_if:.Adult,false"`
+	}
+	v.AssertValid(Declined{Adult: true, Tracking: "yes"})
+	v.AssertInvalid(Declined{Adult: false, Tracking: "yes"})
+	v.AssertValid(Declined{Adult: false, Tracking: "no"})
+
+	err := GoValidate(nil, nil, Test{Country: "nl"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The Terms field must be accepted when Country is nl.", err.Error())
+}
+
+func TestSameAndDifferent(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Password       string
+		RepeatPassword string `validate:"same:.Password"`
+		OldPassword    string `validate:"different:.Password"`
+	}
+	v.AssertValid(Test{Password: "secret", RepeatPassword: "secret", OldPassword: "old"})
+	v.AssertInvalid(Test{Password: "secret", RepeatPassword: "other", OldPassword: "old"})
+	v.AssertInvalid(Test{Password: "secret", RepeatPassword: "secret", OldPassword: "secret"})
+
+	type Nested struct {
+		Email string
+		Inner struct {
+			Email *string `validate:"same:Email"`
+		}
+	}
+	email := "john@example.org"
+	nested := Nested{Email: email}
+	nested.Inner.Email = &email
+	v.AssertValid(nested)
+	nested.Email = "jane@example.org"
+	v.AssertInvalid(nested)
+
+	err := JsonValidate(nil, nil, Test{Password: "secret", RepeatPassword: "other"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The RepeatPassword field must match Password.", err.Error())
+
+	err = JsonValidate(nil, nil, struct {
+		Password       string `json:"password"`
+		RepeatPassword string `json:"repeat_password" validate:"same:.Password"`
+	}{Password: "secret", RepeatPassword: "other"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The repeat_password field must match password.", err.Error())
+}
+
+func TestDistinct(t *testing.T) {
+	v := &testValidator{t}
+
+	type Inner struct {
+		Emails []string `validateInner:"distinct:ignore_case"`
+	}
+	v.AssertValid(Inner{Emails: []string{"a@example.org", "b@example.org"}})
+	v.AssertInvalid(Inner{Emails: []string{"a@example.org", "A@example.org"}})
+
+	type Item struct {
+		Sku string `json:"sku" validate:"distinct"`
+	}
+	type Items struct {
+		Items []Item `json:"items"`
+	}
+	v.AssertValid(Items{Items: []Item{{"a"}, {"b"}}})
+
+	err := JsonValidate(nil, nil, Items{Items: []Item{{"a"}, {"b"}, {"a"}}})
+	assert.NotNil(t, err)
+	paths := []string{}
+	for _, fieldErr := range err.(*ValidationError).Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	assert.Equal(t, []string{"items.0.sku", "items.2.sku"}, paths)
+
+	type List struct {
+		Tags []any `json:"tags" validate:"distinct"`
+	}
+	v.AssertValid(List{Tags: []any{"a", "b"}})
+	err = JsonValidate(nil, nil, List{Tags: []any{"a", "b", "a", "c", "a"}})
+	assert.NotNil(t, err)
+	paths = []string{}
+	for _, fieldErr := range err.(*ValidationError).Errors {
+		paths = append(paths, fieldErr.Path)
+	}
+	assert.Equal(t, []string{"tags.0", "tags.2", "tags.4"}, paths)
+
+	type Strict struct {
+		Tags []any `validate:"distinct:strict"`
+	}
+	v.AssertInvalid(List{Tags: []any{"1", 1}})
+	v.AssertValid(Strict{Tags: []any{"1", 1}})
+}
+
+func TestInArray(t *testing.T) {
+	v := &testValidator{t}
+
+	type Test struct {
+		Tags    []string
+		Primary string `validate:"in_array:.Tags.*"`
+	}
+	v.AssertValid(Test{Tags: []string{"a", "b"}, Primary: "b"})
+	v.AssertInvalid(Test{Tags: []string{"a", "b"}, Primary: "c"})
+	v.AssertInvalid(Test{Primary: "c"})
+
+	type Product struct {
+		Sku string `json:"sku"`
+	}
+	type Order struct {
+		Products []Product `json:"products"`
+		Lines    []struct {
+			Sku string `json:"sku" validate:"in_array:Products.*.Sku"`
+		} `json:"lines"`
+	}
+	order := Order{Products: []Product{{"a"}, {"b"}}}
+	order.Lines = append(order.Lines, struct {
+		Sku string `json:"sku" validate:"in_array:Products.*.Sku"`
+	}{"b"})
+	v.AssertValid(order)
+	order.Lines[0].Sku = "c"
+	v.AssertInvalid(order)
+
+	err := JsonValidate(nil, nil, order)
+	assert.NotNil(t, err)
+	assert.Equal(t, "The sku field must exist in products.*.sku.", err.Error())
+
+	err = JsonValidate(nil, nil, struct {
+		Tags    []string `json:"tags"`
+		Primary string   `json:"primary" validate:"in_array:.Tags.*"`
+	}{Primary: "c"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The primary field must exist in tags.", err.Error())
+}
+
+func TestRequiredArrayKeys(t *testing.T) {
+	validationRulePasses(t, RequiredArrayKeys, map[string]int{"a": 1, "b": 0}, []string{"a", "b"})
+	validationRuleInvalid(t, RequiredArrayKeys, map[string]int{"a": 1}, []string{"a", "b"})
+	validationRuleInvalid(t, RequiredArrayKeys, map[string]int(nil), []string{"a"})
+	validationRuleInvalid(t, RequiredArrayKeys, "foo", []string{"a"})
+
+	type Code string
+	validationRulePasses(t, RequiredArrayKeys, map[Code]int{"a": 1}, []string{"a"})
+	validationRuleInvalid(t, RequiredArrayKeys, map[Code]int{"a": 1}, []string{"b"})
+	validationRulePasses(t, RequiredArrayKeys, map[int]int{1: 1, 2: 0}, []string{"1", "2"})
+	validationRuleInvalid(t, RequiredArrayKeys, map[int]int{1: 1}, []string{"1", "2"})
+	validationRulePasses(t, RequiredArrayKeys, map[uint8]string{3: ""}, []string{"3"})
+}
+
+func TestNumeric(t *testing.T) {
+	for _, value := range []any{1, uint8(1), 1.5, "12", "12.50", "-1.5", "+.5", "5.", "1e3", "1.5E-3", " 12 "} {
+		validationRulePasses(t, Numeric, value, nil)
+	}
+	for _, value := range []any{"", "abc", "1e", "0x1A", "1.2.3", ".", "-", "1 2", true} {
+		validationRuleInvalid(t, Numeric, value, nil)
+	}
+}
+
+func TestInteger(t *testing.T) {
+	for _, value := range []any{1, uint8(1), -1, 2.0, "12", "-12", "+12"} {
+		validationRulePasses(t, Integer, value, nil)
+	}
+	for _, value := range []any{1.5, "1.5", "1e3", "abc", "", true} {
+		validationRuleInvalid(t, Integer, value, nil)
+	}
+
+	validationRulePasses(t, Integer, 1, []string{"strict"})
+	validationRuleInvalid(t, Integer, 2.0, []string{"strict"})
+	validationRuleInvalid(t, Integer, "12", []string{"strict"})
+}
+
+func TestDecimal(t *testing.T) {
+	validationRulePasses(t, Decimal, "12.50", []string{"2"})
+	validationRulePasses(t, Decimal, 12.5, []string{"1"})
+	validationRulePasses(t, Decimal, 12, []string{"0"})
+	validationRuleInvalid(t, Decimal, "12.5", []string{"2"})
+	validationRuleInvalid(t, Decimal, 12.55, []string{"1"})
+	validationRuleInvalid(t, Decimal, "1e3", []string{"0"})
+	validationRuleInvalid(t, Decimal, "abc", []string{"2"})
+
+	validationRulePasses(t, Decimal, "12.5", []string{"1", "4"})
+	validationRulePasses(t, Decimal, "12.1234", []string{"1", "4"})
+	validationRuleInvalid(t, Decimal, "12", []string{"1", "4"})
+	validationRuleInvalid(t, Decimal, "12.12345", []string{"1", "4"})
+
+	type Test struct {
+		Price string `json:"price" validate:"decimal:1,4"`
+	}
+	err := JsonValidate(nil, nil, Test{Price: "12"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The price field must have between 1 and 4 decimal places.", err.Error())
+
+	validationRuleInvalid(t, Decimal, "12.50", []string{"two"})
+}
+
+func TestMultipleOf(t *testing.T) {
+	validationRulePasses(t, MultipleOf, "12.50", []string{"0.25"})
+	validationRulePasses(t, MultipleOf, 0.3, []string{"0.1"})
+	validationRulePasses(t, MultipleOf, 10, []string{"5"})
+	validationRulePasses(t, MultipleOf, "1e3", []string{"10"})
+	validationRulePasses(t, MultipleOf, -7.5, []string{"2.5"})
+	validationRuleInvalid(t, MultipleOf, "12.60", []string{"0.25"})
+	validationRuleInvalid(t, MultipleOf, 11, []string{"5"})
+	validationRuleInvalid(t, MultipleOf, 10, []string{"0"})
+	validationRuleInvalid(t, MultipleOf, "abc", []string{"5"})
+	validationRuleInvalid(t, MultipleOf, "1e999999999", []string{"5"})
+
+	type Test struct {
+		Amount float64 `json:"amount" validate:"multiple_of:0.25"`
+	}
+	err := JsonValidate(nil, nil, Test{Amount: 1.1})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The amount field must be a multiple of 0.25.", err.Error())
+
+	// Invalid divisors fail the rule and are reported by Check
+	validationRuleInvalid(t, MultipleOf, 10, []string{"abc"})
+	validationRuleInvalid(t, MultipleOf, 10, []string{"1e999999999"})
+
+	type Invalid struct {
+		Amount float64 `json:"amount" validate:"multiple_of:abc"`
+	}
+	assert.ErrorIs(t, Check(Invalid{}), ErrInvalidArgs)
+}
+
+type testStatus string
+
+func (testStatus) Values() []testStatus {
+	return []testStatus{"active", "inactive"}
+}
+
+type testLevel int
+
+func (l *testLevel) IsValid() bool {
+	return *l >= 1 && *l <= 3
+}
+
+func TestEnum(t *testing.T) {
+	validationRulePasses(t, Enum, testStatus("active"), nil)
+	validationRuleInvalid(t, Enum, testStatus("deleted"), nil)
+	validationRulePasses(t, Enum, testLevel(2), nil)
+	validationRuleInvalid(t, Enum, testLevel(4), nil)
+	validationRuleInvalid(t, Enum, "active", nil)
+
+	v := &testValidator{t}
+	type Test struct {
+		Status *testStatus `json:"status" validate:"enum"`
+	}
+	v.AssertValid(Test{})
+
+	status := testStatus("deleted")
+	err := JsonValidate(nil, nil, Test{Status: &status})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The selected status is invalid, permitted values are active, inactive.", err.Error())
+
+	type LevelTest struct {
+		Level testLevel `json:"level" validate:"enum"`
+	}
+	err = JsonValidate(nil, nil, LevelTest{Level: 5})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The selected level is invalid.", err.Error())
+}
+
+func TestPassword(t *testing.T) {
+	args := []string{"min=8", "letters", "mixed", "numbers", "symbols"}
+	validationRulePasses(t, Password, "Sup3r-secret", args)
+	validationRuleInvalid(t, Password, "S3-cret", args)
+	validationRuleInvalid(t, Password, "12345678-", args)
+	validationRuleInvalid(t, Password, "sup3r-secret", args)
+	validationRuleInvalid(t, Password, "Super-secret", args)
+	validationRuleInvalid(t, Password, "Sup3rsecret", args)
+	validationRulePasses(t, Password, "wachtwoord", []string{"max=10"})
+	validationRuleInvalid(t, Password, "wachtwoord1", []string{"max=10"})
+
+	type Test struct {
+		Password string `json:"password" validate:"password:min=12,mixed"`
+	}
+	err := JsonValidate(nil, nil, Test{Password: "short"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The password field must be at least 12 characters.", err.Error())
+
+	err = JsonValidate(nil, nil, Test{Password: "long but no uppercase"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The password field must contain at least one uppercase and one lowercase letter.", err.Error())
+
+	// Invalid options would weaken the policy so they fail the rule and are reported by Check
+	validationRuleInvalid(t, Password, "Sup3r-secret", []string{"min=abc"})
+	validationRuleInvalid(t, Password, "Sup3r-secret", []string{"mixd"})
+	validationRuleInvalid(t, Password, "Sup3r-secret", []string{"mixed=1"})
+
+	type Invalid struct {
+		Password string `json:"password" validate:"password:min=abc,mixd"`
+	}
+	assert.ErrorIs(t, Check(Invalid{}), ErrInvalidArgs)
+}
+
+func TestPasswordUncompromised(t *testing.T) {
+	validationRulePasses(t, Password, "password", []string{"uncompromised"})
+
+	SetCompromisedPasswordChecker(NewCompromisedPasswordSet("password"))
+	defer SetCompromisedPasswordChecker(nil)
+
+	validationRuleInvalid(t, Password, "password", []string{"uncompromised"})
+	validationRulePasses(t, Password, "correct horse battery staple", []string{"uncompromised"})
+
+	// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	file := filepath.Join(t.TempDir(), "hashes.txt")
+	err := os.WriteFile(file, []byte("# leaked hashes\n5baa6:3861493\n"), 0o644)
+	assert.NoError(t, err)
+
+	set, err := LoadCompromisedPasswordFile(file)
+	assert.NoError(t, err)
+	SetCompromisedPasswordChecker(set)
+
+	validationRuleInvalid(t, Password, "password", []string{"uncompromised"})
+	validationRulePasses(t, Password, "correct horse battery staple", []string{"uncompromised"})
+}
+
+func testFileHeader(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	return form.File["file"][0]
+}
+
+func testPng(t *testing.T, width, height int) []byte {
+	buf := &bytes.Buffer{}
+	err := png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height)))
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestFileAndImage(t *testing.T) {
+	text := testFileHeader(t, "notes.txt", []byte("hello world"))
+	avatar := testFileHeader(t, "avatar.png", testPng(t, 300, 200))
+
+	validationRulePasses(t, File, text, nil)
+	validationRulePasses(t, File, avatar, nil)
+	validationRuleInvalid(t, File, "notes.txt", nil)
+
+	validationRulePasses(t, Image, avatar, nil)
+	validationRuleInvalid(t, Image, text, nil)
+
+	validationRulePasses(t, Dimensions, avatar, []string{"min_width=300", "max_height=200", "ratio=3/2"})
+	validationRulePasses(t, Dimensions, avatar, []string{"width=300", "height=200", "ratio=1.5"})
+	validationRuleInvalid(t, Dimensions, avatar, []string{"min_width=301"})
+	validationRuleInvalid(t, Dimensions, avatar, []string{"max_height=199"})
+	validationRuleInvalid(t, Dimensions, avatar, []string{"ratio=1/1"})
+	validationRuleInvalid(t, Dimensions, text, []string{"min_width=1"})
+}
+
+func TestFileSize(t *testing.T) {
+	file := testFileHeader(t, "document.pdf", make([]byte, 2048))
+
+	validationRulePasses(t, Size, file, []string{"2"})
+	validationRuleInvalid(t, Size, file, []string{"3"})
+	validationRulePasses(t, Max, file, []string{"2"})
+	validationRuleInvalid(t, Max, file, []string{"1"})
+	validationRulePasses(t, Min, file, []string{"1.5"})
+	validationRuleInvalid(t, Min, file, []string{"3"})
+	validationRulePasses(t, Between, file, []string{"1", "3"})
+	validationRuleInvalid(t, Between, file, []string{"3", "4"})
+
+	type Test struct {
+		Document *multipart.FileHeader   `json:"document" validate:"required|max:1"`
+		Images   []*multipart.FileHeader `json:"images" validateInner:"image"`
+	}
+	err := JsonValidate(nil, nil, Test{Document: file})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The document field must not be greater than 1 kilobytes.", err.Error())
+
+	err = JsonValidate(nil, nil, Test{Document: &multipart.FileHeader{}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The document field is required.", err.Error())
+
+	err = JsonValidate(nil, nil, Test{
+		Document: testFileHeader(t, "small.pdf", []byte("%PDF")),
+		Images:   []*multipart.FileHeader{file},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The 0 field must be an image.", err.Error())
+
+	type Attachments struct {
+		Files []*multipart.FileHeader `json:"files" validate:"max:2" validateInner:"file|max:1"`
+	}
+	small := testFileHeader(t, "small.pdf", []byte("%PDF"))
+	assert.NoError(t, JsonValidate(nil, nil, Attachments{Files: []*multipart.FileHeader{small, small}}))
+
+	err = JsonValidate(nil, nil, Attachments{Files: []*multipart.FileHeader{small, file}})
+	if assert.Error(t, err) {
+		assert.Equal(t, "files.1", err.(*ValidationError).Errors[0].Path)
+		assert.Equal(t, "The 1 field must not be greater than 1 kilobytes.", err.Error())
+	}
+
+	err = JsonValidate(nil, nil, Attachments{Files: []*multipart.FileHeader{small, small, small}})
+	if assert.Error(t, err) {
+		assert.Equal(t, "files", err.(*ValidationError).Errors[0].Path)
+	}
+}
+
+func TestContentTypeMimes(t *testing.T) {
+	pngContent := testPng(t, 1, 1)
+	pdfContent := []byte("%PDF-1.7\n%fake pdf")
+
+	validationRulePasses(t, Mimes, pngContent, []string{"png", "jpg"})
+	validationRuleInvalid(t, Mimes, pdfContent, []string{"png", "jpg"})
+	validationRulePasses(t, Mimes, bytes.NewReader(pdfContent), []string{"pdf"})
+	validationRulePasses(t, Mimes, []byte("name,email\n"), []string{"csv", "txt"})
+	validationRulePasses(t, Mimes, []byte("PK\x03\x04rest of a docx"), []string{"docx"})
+	validationRuleInvalid(t, Mimes, []byte("PK\x03\x04rest of a docx"), []string{"pdf"})
+
+	validationRulePasses(t, Mimetypes, pngContent, []string{"image/*"})
+	validationRulePasses(t, Mimetypes, testFileHeader(t, "avatar.png", pngContent), []string{"image/png"})
+	validationRuleInvalid(t, Mimetypes, testFileHeader(t, "avatar.png", pdfContent), []string{"image/png"})
+
+	// Plain strings are still validated as a mimetype claimed by the client
+	validationRulePasses(t, Mimetypes, "image/png", []string{"image/png"})
+	validationRuleInvalid(t, Mimetypes, "application/pdf", []string{"image/png"})
+
+	type Test struct {
+		Avatar   *multipart.FileHeader `json:"avatar" validate:"mimes:png,jpg"`
+		Contents []byte                `json:"contents" validate:"mimetypes:application/pdf"`
+	}
+	err := JsonValidate(nil, nil, Test{Avatar: testFileHeader(t, "avatar.png", pdfContent)})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The avatar field must be a file of type: png, jpg, the detected type is application/pdf while image/png was declared.", err.Error())
+
+	err = JsonValidate(nil, nil, Test{Contents: pngContent})
+	assert.NotNil(t, err)
+	assert.Equal(t, "The contents field must be a file of type: application/pdf, the detected type is image/png.", err.Error())
+}
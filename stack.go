@@ -1,7 +1,10 @@
 package laravalidate
 
 import (
+	"cmp"
+	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -11,8 +14,13 @@ type StackKind uint8
 const (
 	StackKindObject StackKind = iota
 	StackKindList
+	StackKindMap    // An entry of a map, the names contain the formatted key
+	StackKindMapKey // The key of a map entry, it follows the StackKindMap element and the names are always MapKeyName
 )
 
+// MapKeyName is the name of the stack element used for validating map keys, errors of the validateKey rules have paths like "tags.a1.@key"
+const MapKeyName = "@key"
+
 type StackElement struct {
 	GoName     string
 	JsonName   string
@@ -53,7 +61,54 @@ func (s Stack) AppendIndex(index int, parent *reflect.Value, parentType reflect.
 	})
 }
 
+func (s Stack) AppendMapKey(key reflect.Value, parent *reflect.Value, parentType reflect.Type) Stack {
+	keyStr := formatMapKey(key)
+	return append(s, StackElement{
+		GoName:     keyStr,
+		JsonName:   keyStr,
+		FormName:   keyStr,
+		Index:      -1,
+		Kind:       StackKindMap,
+		Parent:     parent,
+		ParentType: parentType,
+	})
+}
+
+// AppendMapKeyElement appends the element used for validating the key of the map entry at the end of the stack
+func (s Stack) AppendMapKeyElement(parent *reflect.Value, parentType reflect.Type) Stack {
+	return append(s, StackElement{
+		GoName:     MapKeyName,
+		JsonName:   MapKeyName,
+		FormName:   MapKeyName,
+		Index:      -1,
+		Kind:       StackKindMapKey,
+		Parent:     parent,
+		ParentType: parentType,
+	})
+}
+
+// appendElement appends a prepared stack element, see structPlan
+func (s Stack) appendElement(element StackElement, parent *reflect.Value) Stack {
+	element.Parent = parent
+	return append(s, element)
+}
+
 func (s Stack) AppendField(field reflect.StructField, parent *reflect.Value, parentType reflect.Type) Stack {
+	goName, jsonName, formName := fieldNames(field)
+
+	return append(s, StackElement{
+		GoName:     goName,
+		JsonName:   jsonName,
+		FormName:   formName,
+		Index:      -1,
+		Kind:       StackKindObject,
+		Parent:     parent,
+		ParentType: parentType,
+	})
+}
+
+// fieldNames returns the go, json and form name of a struct field
+func fieldNames(field reflect.StructField) (golang, json, form string) {
 	jsonTag, ok := field.Tag.Lookup("json")
 	jsonName := field.Name
 	if ok {
@@ -74,15 +129,56 @@ func (s Stack) AppendField(field reflect.StructField, parent *reflect.Value, par
 		}
 	}
 
-	return append(s, StackElement{
-		GoName:     field.Name,
-		JsonName:   jsonName,
-		FormName:   formName,
-		Index:      -1,
-		Kind:       StackKindObject,
-		Parent:     parent,
-		ParentType: parentType,
+	return field.Name, jsonName, formName
+}
+
+// formatMapKey formats a map key the same way encoding/json does for object keys
+func formatMapKey(key reflect.Value) string {
+	for key.Kind() == reflect.Ptr || key.Kind() == reflect.Interface {
+		if key.IsNil() {
+			return ""
+		}
+		key = key.Elem()
+	}
+
+	switch key.Kind() {
+	case reflect.String:
+		return key.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(key.Bool())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(key.Float(), 'f', -1, 64)
+	}
+
+	if key.CanInterface() {
+		return fmt.Sprint(key.Interface())
+	}
+	return ""
+}
+
+// sortedMapKeys returns the keys of a map in a deterministic order
+// Numeric keys are sorted by their value, other keys by their formatted value
+func sortedMapKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return cmp.Compare(a.Uint(), b.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(a.Float(), b.Float())
+		default:
+			return cmp.Compare(formatMapKey(a), formatMapKey(b))
+		}
 	})
+
+	return keys
 }
 
 // LooslyEquals checks if the stack is equal to the given key
@@ -106,6 +202,20 @@ func (s Stack) LooslyEqualsWithRule(key string, rule string) bool {
 		}
 		stackEl := stackCopy[0]
 
+		if stackEl.Kind == StackKindMap && (part == "*" || stackEl.GoName == part) {
+			// The part is a map key or wildcard
+			stackCopy = stackCopy[1:]
+			continue
+		}
+
+		if stackEl.Kind == StackKindMapKey {
+			if part != MapKeyName {
+				return false
+			}
+			stackCopy = stackCopy[1:]
+			continue
+		}
+
 		parsedPart, err := strconv.Atoi(part)
 		if err == nil && parsedPart >= 0 {
 			// The part is an array index, the next stack element must be a list entry
@@ -132,7 +242,7 @@ func (s Stack) LooslyEqualsWithRule(key string, rule string) bool {
 
 		// The part is an object field, the next stack element must be an object
 		for idx, elem := range stackCopy {
-			if elem.Kind == StackKindList {
+			if elem.Kind == StackKindList || elem.Kind == StackKindMap {
 				continue
 			}
 
@@ -155,7 +265,7 @@ func (s Stack) LooslyEqualsWithRule(key string, rule string) bool {
 
 	skippedGoRuleName := false
 	for _, elem := range stackCopy {
-		if elem.Kind == StackKindList {
+		if elem.Kind == StackKindList || elem.Kind == StackKindMap {
 			continue
 		}
 
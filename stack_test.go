@@ -68,6 +68,42 @@ func TestStackLooslyEquals(t *testing.T) {
 			"Foo.Bar.required",
 			true,
 		},
+		{
+			"map key",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindObject, GoName: "Street"}},
+			"Addresses.home.Street",
+			true,
+		},
+		{
+			"map wildcard",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindObject, GoName: "Street"}},
+			"Addresses.*.Street",
+			true,
+		},
+		{
+			"map without key",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindObject, GoName: "Street"}},
+			"Addresses.Street",
+			true,
+		},
+		{
+			"map other key",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindObject, GoName: "Street"}},
+			"Addresses.work.Street",
+			false,
+		},
+		{
+			"map key",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindMapKey, GoName: MapKeyName}},
+			"Addresses.*.@key",
+			true,
+		},
+		{
+			"map value rule does not match the key",
+			Stack{{Kind: StackKindObject, GoName: "Addresses"}, {Kind: StackKindMap, GoName: "home"}, {Kind: StackKindMapKey, GoName: MapKeyName}},
+			"Addresses.*",
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -6,9 +6,14 @@ import (
 )
 
 func RegisterNlTranslations() {
-	RegisterMessages(language.Dutch, map[string]MessageResolver{
-		"accepted": BasicMessageResolver("Het :attribute veld moet worden geaccepteerd."),
-		// "accepted_if": BasicMessageResolver("Het :attribute veld moet worden geaccepteerd wanneer :other :value is."),
+	RegisterNlTranslationsFor(Default())
+}
+
+// RegisterNlTranslationsFor registers the Dutch messages on the given instance
+func RegisterNlTranslationsFor(instance *Instance) {
+	instance.RegisterMessages(language.Dutch, map[string]MessageResolver{
+		"accepted":       BasicMessageResolver("Het :attribute veld moet worden geaccepteerd."),
+		"accepted_if":    BasicMessageResolver("Het :attribute veld moet worden geaccepteerd wanneer :other :value is."),
 		"active_url":     BasicMessageResolver("Het :attribute veld moet een geldige URL zijn."),
 		"after":          BasicMessageResolver("Het :attribute veld moet een datum zijn na :date."),
 		"after_or_equal": BasicMessageResolver("Het :attribute veld moet een datum zijn na of gelijk aan :date."),
@@ -37,23 +42,33 @@ func RegisterNlTranslations() {
 		"date": BasicMessageResolver("Het :attribute veld moet een geldige datum zijn."),
 		// "date_equals": BasicMessageResolver("Het :attribute veld moet een datum zijn gelijk aan :date."),
 		"date_format": BasicMessageResolver("Het :attribute veld moet overeenkomen met het formaat :arg."),
-		// "decimal": BasicMessageResolver("Het :attribute veld moet :arg decimalen hebben."),
-		"declined": BasicMessageResolver("Het :attribute veld moet worden afgewezen."),
-		// "declined_if": BasicMessageResolver("Het :attribute veld moet worden afgewezen wanneer :other :value is."),
-		// "different":   BasicMessageResolver("Het :attribute veld en :other moeten verschillend zijn."),
+		"decimal": MessageHintResolver{
+			Fallback: "Het :attribute veld moet :arg decimalen hebben.",
+			Hints: map[string]string{
+				"between": "Het :attribute veld moet tussen :arg0 en :arg1 decimalen hebben.",
+			},
+		},
+		"declined":       BasicMessageResolver("Het :attribute veld moet worden afgewezen."),
+		"declined_if":    BasicMessageResolver("Het :attribute veld moet worden afgewezen wanneer :other :value is."),
+		"different":      BasicMessageResolver("Het :attribute veld en :other moeten verschillend zijn."),
 		"digits":         BasicMessageResolver("Het :attribute veld moet :digits cijfers lang zijn."),
 		"digits_between": BasicMessageResolver("Het :attribute veld moet tussen :arg0 en :arg1 cijfers lang zijn."),
-		// "dimensions":        BasicMessageResolver("Het :attribute veld heeft ongeldige afbeeldingsdimensies."),
-		// "distinct":          BasicMessageResolver("Het :attribute veld heeft een dubbele waarde."),
+		"dimensions":     BasicMessageResolver("Het :attribute veld heeft ongeldige afbeeldingsdimensies."),
+		"distinct":       BasicMessageResolver("Het :attribute veld heeft een dubbele waarde."),
 		// "doesnt_end_with":   BasicMessageResolver("Het :attribute veld mag niet eindigen met een van de volgende: :args."),
 		// "doesnt_start_with": BasicMessageResolver("Het :attribute veld mag niet beginnen met een van de volgende: :args."),
 		"email":     BasicMessageResolver("Het :attribute veld moet een geldig e-mailadres zijn."),
 		"ends_with": BasicMessageResolver("Het :attribute veld moet eindigen met een van de volgende: :args."),
-		// "enum":    BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
+		"enum": MessageHintResolver{
+			Fallback: "De geselecteerde :attribute is ongeldig.",
+			Hints: map[string]string{
+				"values": "De geselecteerde :attribute is ongeldig, toegestane waarden zijn :values.",
+			},
+		},
 		"exists":     BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
 		"extensions": BasicMessageResolver("Het :attribute veld moet een van de volgende extensies hebben: :args."),
-		// "file":       BasicMessageResolver("Het :attribute veld moet een bestand zijn."),
-		"filled": BasicMessageResolver("Het :attribute veld moet een waarde hebben."),
+		"file":       BasicMessageResolver("Het :attribute veld moet een bestand zijn."),
+		"filled":     BasicMessageResolver("Het :attribute veld moet een waarde hebben."),
 		"gt": MessageHintResolver{Hints: map[string]string{
 			"array":   "Het :attribute veld moet meer dan :value items bevatten.",
 			"file":    "Het :attribute veld moet groter zijn dan :value kilobytes.",
@@ -67,14 +82,14 @@ func RegisterNlTranslations() {
 			"string":  "Het :attribute veld moet groter zijn dan of gelijk aan :value tekens.",
 		}},
 		"hex_color": BasicMessageResolver("Het :attribute veld moet een geldige hexadecimale kleur zijn."),
-		// "image":     BasicMessageResolver("Het :attribute veld moet een afbeelding zijn."),
-		"in": BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
-		// "in_array":  BasicMessageResolver("Het :attribute veld moet bestaan in :other."),
-		// "integer":   BasicMessageResolver("Het :attribute veld moet een geheel getal zijn."),
-		"ip":   BasicMessageResolver("Het :attribute veld moet een geldig IP-adres zijn."),
-		"ipv4": BasicMessageResolver("Het :attribute veld moet een geldig IPv4-adres zijn."),
-		"ipv6": BasicMessageResolver("Het :attribute veld moet een geldig IPv6-adres zijn."),
-		"json": BasicMessageResolver("Het :attribute veld moet een geldige JSON-tekst zijn."),
+		"image":     BasicMessageResolver("Het :attribute veld moet een afbeelding zijn."),
+		"in":        BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
+		"in_array":  BasicMessageResolver("Het :attribute veld moet bestaan in :other."),
+		"integer":   BasicMessageResolver("Het :attribute veld moet een geheel getal zijn."),
+		"ip":        BasicMessageResolver("Het :attribute veld moet een geldig IP-adres zijn."),
+		"ipv4":      BasicMessageResolver("Het :attribute veld moet een geldig IPv4-adres zijn."),
+		"ipv6":      BasicMessageResolver("Het :attribute veld moet een geldig IPv6-adres zijn."),
+		"json":      BasicMessageResolver("Het :attribute veld moet een geldige JSON-tekst zijn."),
 		// "list":      BasicMessageResolver("Het :attribute veld moet een lijst zijn."),
 		"lowercase": BasicMessageResolver("Het :attribute veld moet in kleine letters zijn."),
 		"lt": MessageHintResolver{Hints: map[string]string{
@@ -100,8 +115,20 @@ func RegisterNlTranslations() {
 			},
 		},
 		"max_digits": BasicMessageResolver("Het :attribute veld mag niet meer dan :max cijfers hebben."),
-		"mimes":      BasicMessageResolver("Het :attribute veld moet een bestand zijn van het type: :args."),
-		"mimetypes":  BasicMessageResolver("Het :attribute veld moet een bestand zijn van het type: :args."),
+		"mimes": MessageHintResolver{
+			Fallback: "Het :attribute veld moet een bestand zijn van het type: :args.",
+			Hints: map[string]string{
+				"detected": "Het :attribute veld moet een bestand zijn van het type: :args, het gedetecteerde type is :detected.",
+				"declared": "Het :attribute veld moet een bestand zijn van het type: :args, het gedetecteerde type is :detected terwijl :declared was opgegeven.",
+			},
+		},
+		"mimetypes": MessageHintResolver{
+			Fallback: "Het :attribute veld moet een bestand zijn van het type: :args.",
+			Hints: map[string]string{
+				"detected": "Het :attribute veld moet een bestand zijn van het type: :args, het gedetecteerde type is :detected.",
+				"declared": "Het :attribute veld moet een bestand zijn van het type: :args, het gedetecteerde type is :detected terwijl :declared was opgegeven.",
+			},
+		},
 		"min": MessageHintResolver{
 			Fallback: "Het :attribute veld moet minimaal :arg zijn.",
 			Hints: map[string]string{
@@ -111,45 +138,50 @@ func RegisterNlTranslations() {
 				"string":  "Het :attribute veld moet minimaal :arg tekens lang zijn.",
 			},
 		},
-		"min_digits": BasicMessageResolver("Het :attribute veld moet minimaal :arg cijfers hebben."),
-		// "missing":          BasicMessageResolver("Het :attribute veld moet ontbreken."),
-		// "missing_if":       BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :other :value is."),
-		// "missing_unless":   BasicMessageResolver("Het :attribute veld moet ontbreken tenzij :other in :args is."),
-		// "missing_with":     BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :args aanwezig is."),
-		// "missing_with_all": BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :args aanwezig zijn."),
-		// "multiple_of":      BasicMessageResolver("Het :attribute veld moet een veelvoud van :value zijn."),
-		"not_nil":   BasicMessageResolver("Het :attribute veld mag niet nil zijn."),
-		"not_in":    BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
-		"not_regex": BasicMessageResolver("Het formaat van het :attribute veld is ongeldig."),
-		"numeric":   BasicMessageResolver("Het :attribute veld moet een getal zijn."),
-		// "password": MessageHintResolver{Hints: map[string]string{
-		// 	"letters":       "Het :attribute veld moet minimaal één letter bevatten.",
-		// 	"mixed":         "Het :attribute veld moet minimaal één hoofdletter en één kleine letter bevatten.",
-		// 	"numbers":       "Het :attribute veld moet minimaal één cijfer bevatten.",
-		// 	"symbols":       "Het :attribute veld moet minimaal één symbool bevatten.",
-		// 	"uncompromised": "Het opgegeven :attribute is in een datalek verschenen. Kies een ander :attribute.",
-		// }},
-		// "present":           BasicMessageResolver("Het :attribute veld moet aanwezig zijn."),
-		// "present_if":        BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :other :value is."),
-		// "present_unless":    BasicMessageResolver("Het :attribute veld moet aanwezig zijn tenzij :other in :args is."),
-		// "present_with":      BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :args aanwezig is."),
-		// "present_with_all":  BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :args aanwezig zijn."),
-		// "prohibited":        BasicMessageResolver("Het :attribute veld is verboden."),
-		// "prohibited_if":     BasicMessageResolver("Het :attribute veld is verboden wanneer :other :value is."),
-		// "prohibited_unless": BasicMessageResolver("Het :attribute veld is verboden tenzij :other in :args is."),
-		// "prohibits":         BasicMessageResolver("Het :attribute veld verbiedt :other om aanwezig te zijn."),
-		"regex":    BasicMessageResolver("Het formaat van het :attribute veld is ongeldig."),
-		"required": BasicMessageResolver("Het :attribute veld is verplicht."),
-		// "required_array_keys":  BasicMessageResolver("Het :attribute veld moet entries bevatten voor: :args."),
-		// "required_if":          BasicMessageResolver("Het :attribute veld is verplicht wanneer :other :value is."),
-		// "required_if_accepted": BasicMessageResolver("Het :attribute veld is verplicht wanneer :other is geaccepteerd."),
-		// "required_if_declined": BasicMessageResolver("Het :attribute veld is verplicht wanneer :other is afgewezen."),
-		// "required_unless":      BasicMessageResolver("Het :attribute veld is verplicht tenzij :other in :args is."),
-		// "required_with":        BasicMessageResolver("Het :attribute veld is verplicht wanneer :args aanwezig is."),
-		// "required_with_all":    BasicMessageResolver("Het :attribute veld is verplicht wanneer :args aanwezig zijn."),
-		// "required_without":     BasicMessageResolver("Het :attribute veld is verplicht wanneer :args niet aanwezig is."),
-		// "required_without_all": BasicMessageResolver("Het :attribute veld is verplicht wanneer geen van :args aanwezig zijn."),
-		// "same": BasicMessageResolver("Het :attribute veld moet overeenkomen met :other."),
+		"min_digits":       BasicMessageResolver("Het :attribute veld moet minimaal :arg cijfers hebben."),
+		"missing":          BasicMessageResolver("Het :attribute veld moet ontbreken."),
+		"missing_if":       BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :other :value is."),
+		"missing_unless":   BasicMessageResolver("Het :attribute veld moet ontbreken tenzij :other in :values is."),
+		"missing_with":     BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :others aanwezig is."),
+		"missing_with_all": BasicMessageResolver("Het :attribute veld moet ontbreken wanneer :others aanwezig zijn."),
+		"multiple_of":      BasicMessageResolver("Het :attribute veld moet een veelvoud van :arg zijn."),
+		"not_nil":          BasicMessageResolver("Het :attribute veld mag niet nil zijn."),
+		"not_in":           BasicMessageResolver("De geselecteerde :attribute is ongeldig."),
+		"not_regex":        BasicMessageResolver("Het formaat van het :attribute veld is ongeldig."),
+		"numeric":          BasicMessageResolver("Het :attribute veld moet een getal zijn."),
+		"password": MessageHintResolver{
+			Fallback: "Het :attribute veld moet een tekst zijn.",
+			Hints: map[string]string{
+				"min":           "Het :attribute veld moet minimaal :min tekens zijn.",
+				"max":           "Het :attribute veld mag niet groter zijn dan :max tekens.",
+				"letters":       "Het :attribute veld moet minimaal één letter bevatten.",
+				"mixed":         "Het :attribute veld moet minimaal één hoofdletter en één kleine letter bevatten.",
+				"numbers":       "Het :attribute veld moet minimaal één cijfer bevatten.",
+				"symbols":       "Het :attribute veld moet minimaal één symbool bevatten.",
+				"uncompromised": "Het opgegeven :attribute is in een datalek verschenen. Kies een ander :attribute.",
+			},
+		},
+		"present":              BasicMessageResolver("Het :attribute veld moet aanwezig zijn."),
+		"present_if":           BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :other :value is."),
+		"present_unless":       BasicMessageResolver("Het :attribute veld moet aanwezig zijn tenzij :other in :values is."),
+		"present_with":         BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :others aanwezig is."),
+		"present_with_all":     BasicMessageResolver("Het :attribute veld moet aanwezig zijn wanneer :others aanwezig zijn."),
+		"prohibited":           BasicMessageResolver("Het :attribute veld is verboden."),
+		"prohibited_if":        BasicMessageResolver("Het :attribute veld is verboden wanneer :other :value is."),
+		"prohibited_unless":    BasicMessageResolver("Het :attribute veld is verboden tenzij :other in :values is."),
+		"prohibits":            BasicMessageResolver("Het :attribute veld verbiedt :other om aanwezig te zijn."),
+		"regex":                BasicMessageResolver("Het formaat van het :attribute veld is ongeldig."),
+		"required":             BasicMessageResolver("Het :attribute veld is verplicht."),
+		"required_array_keys":  BasicMessageResolver("Het :attribute veld moet entries bevatten voor: :args."),
+		"required_if":          BasicMessageResolver("Het :attribute veld is verplicht wanneer :other :value is."),
+		"required_if_accepted": BasicMessageResolver("Het :attribute veld is verplicht wanneer :other is geaccepteerd."),
+		"required_if_declined": BasicMessageResolver("Het :attribute veld is verplicht wanneer :other is afgewezen."),
+		"required_unless":      BasicMessageResolver("Het :attribute veld is verplicht tenzij :other in :values is."),
+		"required_with":        BasicMessageResolver("Het :attribute veld is verplicht wanneer :others aanwezig is."),
+		"required_with_all":    BasicMessageResolver("Het :attribute veld is verplicht wanneer :others aanwezig zijn."),
+		"required_without":     BasicMessageResolver("Het :attribute veld is verplicht wanneer :others niet aanwezig is."),
+		"required_without_all": BasicMessageResolver("Het :attribute veld is verplicht wanneer geen van :others aanwezig zijn."),
+		"same":                 BasicMessageResolver("Het :attribute veld moet overeenkomen met :other."),
 		"size": MessageHintResolver{
 			Fallback: "Het :attribute veld moet de grootte :arg hebben.",
 			Hints: map[string]string{
@@ -161,7 +193,7 @@ func RegisterNlTranslations() {
 		"starts_with": BasicMessageResolver("Het :attribute veld moet beginnen met een van de volgende: :args."),
 		// "string":   BasicMessageResolver("Het :attribute veld moet een string zijn."),
 		// "timezone": BasicMessageResolver("Het :attribute veld moet een geldige tijdzone zijn."),
-		// "unique":   BasicMessageResolver("Het :attribute is al in gebruik genomen."),
+		"unique": BasicMessageResolver("Het :attribute is al in gebruik genomen."),
 		// "uploaded": BasicMessageResolver("Het uploaden van het :attribute is mislukt."),
 		"uppercase": BasicMessageResolver("Het :attribute veld moet in hoofdletters zijn."),
 		"url":       BasicMessageResolver("Het :attribute veld moet een geldige URL zijn."),
@@ -0,0 +1,83 @@
+package laravalidate
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ValidatedData describes which parts of the input were validated
+// The input itself is never modified, fields excluded by the exclude rules keep their value but were never validated.
+type ValidatedData struct {
+	Mode Mode
+	// ExcludedPaths contains the paths of the fields excluded by the exclude rules, the paths are formatted like the paths of the errors
+	ExcludedPaths []string
+}
+
+// IsExcluded returns true if the field at the path or one of its parents was excluded
+func (d *ValidatedData) IsExcluded(path string) bool {
+	return slices.ContainsFunc(d.ExcludedPaths, func(excluded string) bool {
+		return path == excluded || strings.HasPrefix(path, excluded+".")
+	})
+}
+
+// Validated validates the input using the default instance, see (*Instance).Validated
+func Validated(ctx context.Context, languages []language.Tag, input any, mode Mode) (*ValidatedData, error) {
+	return defaultInstance.Validated(ctx, languages, input, mode)
+}
+
+// Validated validates the input like JsonValidate, FormValidate or GoValidate depending on the mode
+// and returns which fields were excluded so handlers can skip values that were never validated.
+//
+// The error is the same as the error of the other validate functions, the validated data is only returned if the input is valid.
+// Use ValidatedJsonRaw or ValidatedFormValues if the raw message is available so rules can tell missing fields apart from zero values.
+func (i *Instance) Validated(ctx context.Context, languages []language.Tag, input any, mode Mode) (*ValidatedData, error) {
+	return i.validated(ctx, languages, input, mode, nil)
+}
+
+// ValidatedJsonRaw validates the input using the default instance, see (*Instance).ValidatedJsonRaw
+func ValidatedJsonRaw(ctx context.Context, languages []language.Tag, data []byte, output any) (*ValidatedData, error) {
+	return defaultInstance.ValidatedJsonRaw(ctx, languages, data, output)
+}
+
+// ValidatedJsonRaw decodes and validates the raw json message like JsonValidateRaw and returns the validated data like Validated
+func (i *Instance) ValidatedJsonRaw(ctx context.Context, languages []language.Tag, data []byte, output any) (*ValidatedData, error) {
+	err := json.Unmarshal(data, output)
+	if err != nil {
+		return nil, err
+	}
+
+	presence, err := jsonPresence(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.validated(ctx, languages, output, JsonMode, presence)
+}
+
+// ValidatedFormValues validates the input using the default instance, see (*Instance).ValidatedFormValues
+func ValidatedFormValues(ctx context.Context, languages []language.Tag, values url.Values, input any) (*ValidatedData, error) {
+	return defaultInstance.ValidatedFormValues(ctx, languages, values, input)
+}
+
+// ValidatedFormValues validates an already decoded form message like FormValidateValues and returns the validated data like Validated
+func (i *Instance) ValidatedFormValues(ctx context.Context, languages []language.Tag, values url.Values, input any) (*ValidatedData, error) {
+	return i.validated(ctx, languages, input, FormMode, formPresence(values))
+}
+
+func (i *Instance) validated(ctx context.Context, languages []language.Tag, input any, mode Mode, presence map[string]struct{}) (*ValidatedData, error) {
+	v := i.run(ctx, languages, input, mode, presence)
+	err := v.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidatedData{
+		Mode:          mode,
+		ExcludedPaths: v.excluded,
+	}, nil
+}
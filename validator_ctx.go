@@ -2,6 +2,10 @@ package laravalidate
 
 import (
 	"context"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
 	"strconv"
 	"strings"
 	"time"
@@ -10,7 +14,8 @@ import (
 )
 
 const (
-	ParsedDateKey = "parsed-date" // Stores a custom parsed date, this is used by the date_format validator to store the results so they can be used by other validators
+	ParsedDateKey  = "parsed-date"  // Stores a custom parsed date, this is used by the date_format validator to store the results so they can be used by other validators
+	ImageConfigKey = "image-config" // Stores the decoded image config of an uploaded file so the image rules only have to read the file once
 )
 
 type ValidatorCtx struct {
@@ -26,10 +31,26 @@ type ValidatorCtx struct {
 	// lastObtainedField should contain the last field requested using the (*ValidatorCtx).Field(..) method
 	// Can be nil if no field was requested during the validation
 	lastObtainedField *Needle
+	// obtainedFields contains the paths of all fields requested using the (*ValidatorCtx).Field(..) method
+	// These are used to render the :other and :others message variables
+	obtainedFields []string
+	// elementErrors contains errors reported for elements of the list under validation using (*ValidatorCtx).ElementError(..)
+	elementErrors []elementError
+	// messageVariables contains message variables set by the validator using (*ValidatorCtx).SetMessageVariable(..)
+	messageVariables map[string]string
+	// prepared contains the result of the prepare function of the validator if the rule was prepared, see RegisterPrepare
+	prepared    any
+	preparedSet bool
+}
+
+type elementError struct {
+	index int
+	hint  string
 }
 
 type ValidatorCtxState struct {
 	bail      bool
+	exclude   bool
 	state     map[string]any
 	stack     Stack
 	validator *Validator
@@ -53,23 +74,67 @@ func (ctx *ValidatorCtx) Date() (time.Time, ConvertStatus) {
 	return ctx.Needle.Date()
 }
 
+// ImageConfig decodes the format and dimensions of an uploaded image
+// Supported formats are gif, jpeg and png, other formats can be added by importing their image decoder package
+func (ctx *ValidatorCtx) ImageConfig() (image.Config, ConvertStatus) {
+	state, ok := ctx.GetState(ImageConfigKey)
+	if ok {
+		config, ok := state.(image.Config)
+		if ok {
+			return config, ConverstionOk
+		}
+	}
+
+	file, status := ctx.File()
+	if !status.Oke() {
+		return image.Config{}, status
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return image.Config{}, Invalid
+	}
+	defer f.Close()
+
+	config, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return image.Config{}, Invalid
+	}
+
+	ctx.SetState(ImageConfigKey, config)
+	return config, ConverstionOk
+}
+
+// DateFromArgs parses the arg at argIndex as date, relative dates like "tomorrow" are relative to the current time
+// See parseDateExpression for the supported formats
 func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 	if len(ctx.Args) <= argIndex {
 		return time.Time{}, false
 	}
 
-	argWords := strings.Split(ctx.Args[argIndex], " ")
-	for i := len(argWords) - 1; i >= 0; i-- {
-		word := argWords[i]
-		if word == "" {
-			argWords = append(argWords[:i], argWords[i+1:]...)
+	expression, ok := parseDateExpression(ctx.Args[argIndex])
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return expression(time.Now()), true
+}
+
+// dateExpression resolves a parsed date argument relative to now
+type dateExpression func(now time.Time) time.Time
+
+// parseDateExpression parses a date argument into an expression that can be resolved for any moment
+// Supported are relative dates like "tomorrow", "+2 days" and "first day of next month" and absolute dates, see dates.ParseStructuredDate
+func parseDateExpression(arg string) (dateExpression, bool) {
+	argWords := []string{}
+	for _, word := range strings.Split(arg, " ") {
+		if word != "" {
+			argWords = append(argWords, strings.ToLower(word))
 		}
-		argWords[i] = strings.ToLower(word)
 	}
 
-	now := time.Now()
-	today := func(hour, minute, seconds int) time.Time {
-		return time.Date(now.Year(), now.Month(), now.Day(), hour, minute, seconds, 0, nil)
+	today := func(now time.Time, hour, minute, seconds int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), hour, minute, seconds, 0, now.Location())
 	}
 	nextWeekday := func(base time.Time, day time.Weekday) time.Time {
 		if base.Weekday() == day {
@@ -82,9 +147,6 @@ func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 		}
 		return base.AddDate(0, 0, int(diff))
 	}
-	nextWeekdayFromToday := func(day time.Weekday) time.Time {
-		return nextWeekday(today(0, 0, 0), day)
-	}
 	previousWeekday := func(base time.Time, day time.Weekday) time.Time {
 		if base.Weekday() == day {
 			return base
@@ -101,21 +163,19 @@ func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 	case 1:
 		switch argWords[0] {
 		case "yesterday":
-			return today(0, 0, 0).AddDate(0, 0, -1), true
-		case "midnight":
-			return today(0, 0, 0), true
-		case "today":
-			return today(0, 0, 0), true
+			return func(now time.Time) time.Time { return today(now, 0, 0, 0).AddDate(0, 0, -1) }, true
+		case "midnight", "today":
+			return func(now time.Time) time.Time { return today(now, 0, 0, 0) }, true
 		case "now":
-			return now, true
+			return func(now time.Time) time.Time { return now }, true
 		case "noon":
-			return today(12, 0, 0), true
+			return func(now time.Time) time.Time { return today(now, 12, 0, 0) }, true
 		case "tomorrow":
-			return today(0, 0, 0).AddDate(0, 0, 1), true
+			return func(now time.Time) time.Time { return today(now, 0, 0, 0).AddDate(0, 0, 1) }, true
 		default:
 			weekday, ok := dates.Weekday(argWords[0])
 			if ok {
-				return nextWeekdayFromToday(weekday), true
+				return func(now time.Time) time.Time { return nextWeekday(today(now, 0, 0, 0), weekday) }, true
 			}
 		}
 	case 2:
@@ -123,19 +183,19 @@ func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 		if offsetErr == nil && offset != 0 {
 			switch argWords[1] {
 			case "seconds", "second":
-				return now.Add(time.Duration(offset) * time.Second), true
+				return func(now time.Time) time.Time { return now.Add(time.Duration(offset) * time.Second) }, true
 			case "minutes", "minute":
-				return now.Add(time.Duration(offset) * time.Minute), true
+				return func(now time.Time) time.Time { return now.Add(time.Duration(offset) * time.Minute) }, true
 			case "hours", "hour":
-				return now.Add(time.Duration(offset) * time.Hour), true
+				return func(now time.Time) time.Time { return now.Add(time.Duration(offset) * time.Hour) }, true
 			case "days", "day":
-				return now.AddDate(0, 0, offset), true
+				return func(now time.Time) time.Time { return now.AddDate(0, 0, offset) }, true
 			case "weeks", "week":
-				return now.AddDate(0, 0, offset*7), true
+				return func(now time.Time) time.Time { return now.AddDate(0, 0, offset*7) }, true
 			case "weekdays", "weekday":
-				resp := now
-				// FIXME: This can be done way more efficient
-				if offset > 0 {
+				return func(now time.Time) time.Time {
+					resp := now
+					// FIXME: This can be done way more efficient
 					for i := 0; i < offset; i++ {
 						if resp.Weekday() == time.Friday {
 							resp = resp.AddDate(0, 0, 3)
@@ -143,20 +203,19 @@ func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 							resp = resp.AddDate(0, 0, 1)
 						}
 					}
-				} else {
-					for i := 0; i < offset; i++ {
+					for i := 0; i > offset; i-- {
 						if resp.Weekday() == time.Monday {
 							resp = resp.AddDate(0, 0, -3)
 						} else {
 							resp = resp.AddDate(0, 0, -1)
 						}
 					}
-				}
-				return now, true
+					return resp
+				}, true
 			case "months", "month":
-				return now.AddDate(0, offset, 0), true
+				return func(now time.Time) time.Time { return now.AddDate(0, offset, 0) }, true
 			case "years", "year":
-				return now.AddDate(offset, 0, 0), true
+				return func(now time.Time) time.Time { return now.AddDate(offset, 0, 0) }, true
 			}
 		}
 	case 3:
@@ -164,64 +223,102 @@ func (ctx *ValidatorCtx) DateFromArgs(argIndex int) (time.Time, bool) {
 		if ok {
 			switch strings.Join(argWords[:2], " ") {
 			case "back of":
-				if hour == 24 {
-					return today(0, 15, 0).AddDate(0, 0, 1), true
-				}
-				return today(hour, 15, 0), true
+				return func(now time.Time) time.Time {
+					if hour == 24 {
+						return today(now, 0, 15, 0).AddDate(0, 0, 1)
+					}
+					return today(now, hour, 15, 0)
+				}, true
 			case "front of":
-				if hour == 0 {
-					return today(23, 45, 0).AddDate(0, 0, -1), true
-				}
-				return today(hour-1, 45, 0), true
+				return func(now time.Time) time.Time {
+					if hour == 0 {
+						return today(now, 23, 45, 0).AddDate(0, 0, -1)
+					}
+					return today(now, hour-1, 45, 0)
+				}, true
 			}
 		}
-		weekDay, ok := dates.Weekday(argWords[0])
+		weekday, ok := dates.Weekday(argWords[0])
 		if ok && argWords[2] == "week" {
 			switch argWords[1] {
 			case "last", "previous", "prev":
-				return today(0, 0, 0).AddDate(0, 0, -int(now.Weekday())-7+int(weekDay)), true
+				return func(now time.Time) time.Time {
+					return today(now, 0, 0, 0).AddDate(0, 0, -int(now.Weekday())-7+int(weekday))
+				}, true
 			case "this":
-				return today(0, 0, 0).AddDate(0, 0, -int(now.Weekday())+int(weekDay)), true
+				return func(now time.Time) time.Time {
+					return today(now, 0, 0, 0).AddDate(0, 0, -int(now.Weekday())+int(weekday))
+				}, true
 			case "next":
-				return nextWeekday(now, weekDay).AddDate(0, 0, -int(now.Weekday())+7+int(weekDay)), true
+				return func(now time.Time) time.Time {
+					return today(now, 0, 0, 0).AddDate(0, 0, -int(now.Weekday())+7+int(weekday))
+				}, true
 			}
 		}
 	case 5:
-		month, monthOk := dates.Month(argWords[3])
-		year, yearOk := dates.Year(argWords[4])
-		weekday, weekdayOk := dates.Weekday(argWords[0])
+		if argWords[2] != "of" {
+			break
+		}
+
+		// firstDay returns the first day of the month referred to by the last 2 words
+		var firstDay dateExpression
 		if argWords[4] == "month" {
-			if argWords[3] == "next" {
-				offsetDate := now.AddDate(0, 1, 0)
-				year, yearOk = offsetDate.Year(), true
-				month, monthOk = offsetDate.Month(), true
-			} else if argWords[3] == "previous" || argWords[3] == "prev" || argWords[3] == "last" {
-				offsetDate := now.AddDate(0, -1, 0)
-				year, yearOk = offsetDate.Year(), true
-				month, monthOk = offsetDate.Month(), true
+			offset := 0
+			switch argWords[3] {
+			case "next":
+				offset = 1
+			case "previous", "prev", "last":
+				offset = -1
+			case "this":
+			default:
+				return nil, false
+			}
+			firstDay = func(now time.Time) time.Time {
+				return time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, now.Location())
+			}
+		} else {
+			month, monthOk := dates.Month(argWords[3])
+			year, yearOk := dates.Year(argWords[4])
+			if !monthOk || !yearOk {
+				return nil, false
+			}
+			firstDay = func(now time.Time) time.Time {
+				return time.Date(year, month, 1, 0, 0, 0, 0, now.Location())
 			}
 		}
-		if monthOk && yearOk && argWords[2] == "of" {
-			firstDay := time.Date(year, month, 1, 0, 0, 0, 0, nil)
-			if argWords[1] == "day" {
-				switch argWords[0] {
-				case "first": // "first day of [month] [year]"
-					return firstDay, false
-				case "last": // "last day of [month] [year]"
-					return firstDay.AddDate(0, 1, 0).AddDate(0, 0, -1), false
-				}
-			} else if weekdayOk {
-				switch argWords[0] {
-				case "first": // "first [weekday] of [month] [year]"
-					return nextWeekday(firstDay, weekday), false
-				case "last": // "last [weekday] of [month] [year]"
-					return previousWeekday(firstDay.AddDate(0, 1, 0).AddDate(0, 0, -1), weekday), false
-				}
+
+		if argWords[1] == "day" {
+			switch argWords[0] {
+			case "first": // "first day of [month] [year]"
+				return firstDay, true
+			case "last": // "last day of [month] [year]"
+				return func(now time.Time) time.Time { return firstDay(now).AddDate(0, 1, -1) }, true
+			}
+		} else if weekday, ok := dates.Weekday(argWords[1]); ok {
+			switch argWords[0] {
+			case "first": // "first [weekday] of [month] [year]"
+				return func(now time.Time) time.Time { return nextWeekday(firstDay(now), weekday) }, true
+			case "last": // "last [weekday] of [month] [year]"
+				return func(now time.Time) time.Time { return previousWeekday(firstDay(now).AddDate(0, 1, -1), weekday) }, true
 			}
 		}
 	}
 
-	return dates.ParseStructuredDate(ctx.Value.String())
+	date, ok := dates.ParseStructuredDate(arg)
+	if !ok {
+		return nil, false
+	}
+	return func(time.Time) time.Time { return date }, true
+}
+
+// Prepared returns the args of the rule compiled by the prepare function of the validator, see RegisterPrepare
+// If the rule was not prepared in advance, for example because the validator is called directly, prepare is called with the current args.
+func (ctx *ValidatorCtx) Prepared(prepare PrepareFn) (any, error) {
+	if ctx.preparedSet {
+		return ctx.prepared, nil
+	}
+
+	return prepare(ctx.Args)
 }
 
 // SetState sets a value in the state
@@ -245,6 +342,13 @@ func (ctx *ValidatorCtx) UnBail() {
 	ctx.state.bail = false
 }
 
+// Exclude excludes the field from validation
+// No other validators will run for this field, nested fields are not validated and errors of previous validators are dropped.
+// The input is not modified, the path of the field is reported in the excluded paths returned by Validated so handlers can skip its value
+func (ctx *ValidatorCtx) Exclude() {
+	ctx.state.exclude = true
+}
+
 // BailStatus returns the current bail status, if true the validator will stop after the first error
 func (ctx *ValidatorCtx) BailStatus() bool {
 	return ctx.state.bail
@@ -255,6 +359,7 @@ func (ctx *ValidatorCtx) BailStatus() bool {
 //
 // 1. Absolute path:
 //   - "foo.1.bar" = Get from the input (struct) the field "foo", then when it's a list like get the element at index 1 from the list, then get the field "bar" from the struct
+//   - "foo.*.bar" = Get the field "bar" of every element in the list "foo", the results are combined into a new slice
 //   - "" = Get the source input
 //
 // 2. Relative path:
@@ -272,9 +377,76 @@ func (ctx *ValidatorCtx) Field(key string) *Needle {
 	}
 
 	ctx.lastObtainedField = needle
+	ctx.obtainedFields = append(ctx.obtainedFields, key)
 	return needle
 }
 
+// ElementError reports a validation error for an element of the list under validation instead of for the list itself
+// The error is added using the path of the element, for example `items.2`
+//
+// This can be used to report multiple errors from a single validator, the validator can still return a hint and ok for the list itself
+func (ctx *ValidatorCtx) ElementError(index int, hint string) {
+	if !ctx.HasValue() || !ctx.IsList() {
+		return
+	}
+
+	if index < 0 || index >= ctx.Value.Len() {
+		return
+	}
+
+	ctx.elementErrors = append(ctx.elementErrors, elementError{index: index, hint: hint})
+}
+
+// Abort stops the validation because of a failure that is not caused by the input, for example a database outage
+// The validate functions (JsonValidate, FormValidate, ..) return err instead of a *ValidationError so the caller can tell both apart.
+// The result returned by the validator after calling Abort is ignored, only the first aborted error is kept.
+func (ctx *ValidatorCtx) Abort(err error) {
+	if err == nil {
+		return
+	}
+
+	validator := ctx.state.validator
+	if validator.abortErr == nil {
+		validator.abortErr = err
+	}
+}
+
+// SetMessageVariable sets the value of a message variable for the error message of this validator
+// The name is without the leading colon, for example "values" for :values.
+// Variables set using this method take precedence over the built-in variables.
+func (ctx *ValidatorCtx) SetMessageVariable(name string, value string) {
+	if ctx.messageVariables == nil {
+		ctx.messageVariables = map[string]string{}
+	}
+	ctx.messageVariables[name] = value
+}
+
+// Present returns if the field under validation was present within the input
+//
+// The presence of fields is only known when validating using JsonValidateRaw or FormValidateValues,
+// in other cases a field is considered present if it is not nil
+func (ctx *ValidatorCtx) Present() bool {
+	return ctx.state.validator.present(ctx.state.stack, &ctx.Needle)
+}
+
+// FieldPresent returns if another field was present within the input
+// The path works the same as (*ValidatorCtx).Field(..), see (*ValidatorCtx).Present() for how presence is determined
+func (ctx *ValidatorCtx) FieldPresent(key string) bool {
+	needle := ctx.Field(key)
+
+	validator := ctx.state.validator
+	if validator.presence == nil {
+		return validator.present(nil, needle)
+	}
+
+	stack, ok := validator.fieldStack(ctx.state.stack, key)
+	if !ok {
+		return false
+	}
+
+	return validator.present(stack, needle)
+}
+
 type SimpleStackElement struct {
 	GoName   string
 	JsonName string